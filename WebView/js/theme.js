@@ -21,14 +21,6 @@
 	}
 	navbarFixed();
 
-	/*----------------------------------------------------*/
-	/*  MailChimp Slider
-    /*----------------------------------------------------*/
-	function mailChimp() {
-		$('#mc_embed_signup').find('form').ajaxChimp();
-	}
-	mailChimp();
-
 	$('select').niceSelect();
 	/* ---------------------------------------------
             Isotope js Starts
@@ -0,0 +1,67 @@
+package cv
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"porto/dto"
+)
+
+// maxEntries bounds the cache; it is emptied when full.
+const maxEntries = 32
+
+// Cache keeps rendered PDFs keyed by a hash of the theme and résumé, so a
+// PDF is only rendered again after the underlying data changes.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func NewCache() *Cache {
+	return &Cache{entries: map[string][]byte{}}
+}
+
+// Get returns the PDF of r in the named theme together with its ETag.
+func (c *Cache) Get(r dto.Resume, theme string) ([]byte, string, error) {
+	t, ok := Themes[theme]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown theme %q", theme)
+	}
+	etag, err := ETag(r, theme)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c.mu.Lock()
+	pdf, ok := c.entries[etag]
+	c.mu.Unlock()
+	if ok {
+		return pdf, etag, nil
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, r, t); err != nil {
+		return nil, "", err
+	}
+	c.mu.Lock()
+	if len(c.entries) >= maxEntries {
+		clear(c.entries)
+	}
+	c.entries[etag] = buf.Bytes()
+	c.mu.Unlock()
+	return buf.Bytes(), etag, nil
+}
+
+// ETag identifies the PDF of r in the named theme.
+func ETag(r dto.Resume, theme string) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(theme))
+	if err := json.NewEncoder(h).Encode(r); err != nil {
+		return "", err
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`, nil
+}
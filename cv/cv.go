@@ -0,0 +1,213 @@
+// Package cv renders the résumé as a PDF with the core PDF fonts, so no
+// browser or font files are needed.
+package cv
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"porto/dto"
+	"porto/model"
+)
+
+type rgb [3]int
+
+// Theme controls the typography and colours of the PDF.
+type Theme struct {
+	// Font is a core font family: Helvetica, Times or Courier.
+	Font   string
+	Accent rgb
+	Text   rgb
+	Muted  rgb
+	// Banner draws the header as white text on the accent colour.
+	Banner bool
+}
+
+// DefaultTheme is used when no theme is requested.
+const DefaultTheme = "modern"
+
+var Themes = map[string]Theme{
+	"modern":  {Font: "Helvetica", Accent: rgb{0, 110, 140}, Text: rgb{33, 37, 41}, Muted: rgb{108, 117, 125}, Banner: true},
+	"classic": {Font: "Times", Accent: rgb{60, 60, 60}, Text: rgb{0, 0, 0}, Muted: rgb{90, 90, 90}},
+}
+
+const (
+	pageMargin = 18.0
+	lineHeight = 5.0
+)
+
+// Render writes r as an A4 PDF. Work is rendered in the given order and
+// skills are grouped by their category keyword.
+func Render(w io.Writer, r dto.Resume, theme Theme) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle(r.Basics.Name+" - CV", true)
+	pdf.SetAuthor(r.Basics.Name, true)
+	pdf.SetCreationDate(time.Time{})
+	pdf.AddPage()
+
+	c := &canvas{pdf: pdf, theme: theme, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+	c.header(r.Basics)
+	if len(r.Work) > 0 {
+		c.section("Experience")
+		for _, work := range r.Work {
+			c.entry(work.Position, work.Name, period(work.StartDate, work.EndDate), work.Summary)
+		}
+	}
+	if len(r.Skills) > 0 {
+		c.section("Skills")
+		c.skills(r.Skills)
+	}
+	if len(r.Projects) > 0 {
+		c.section("Projects")
+		for _, p := range r.Projects {
+			c.entry(p.Name, p.URL, strings.Join(p.Keywords, ", "), p.Description)
+		}
+	}
+	if err := pdf.Error(); err != nil {
+		return err
+	}
+	return pdf.Output(w)
+}
+
+type canvas struct {
+	pdf   *fpdf.Fpdf
+	theme Theme
+	tr    func(string) string
+}
+
+func (c *canvas) color(col rgb) {
+	c.pdf.SetTextColor(col[0], col[1], col[2])
+}
+
+func (c *canvas) header(b dto.ResumeBasics) {
+	pdf, t := c.pdf, c.theme
+	width, _ := pdf.GetPageSize()
+	contact := joinNonEmpty(" | ", b.Email, b.Phone, b.URL)
+	if t.Banner {
+		pdf.SetFillColor(t.Accent[0], t.Accent[1], t.Accent[2])
+		pdf.Rect(0, 0, width, 38, "F")
+		pdf.SetY(12)
+		c.color(rgb{255, 255, 255})
+	} else {
+		c.color(t.Text)
+	}
+	pdf.SetFont(t.Font, "B", 22)
+	pdf.CellFormat(0, 10, c.tr(b.Name), "", 1, "L", false, 0, "")
+	pdf.SetFont(t.Font, "", 11)
+	if !t.Banner {
+		c.color(t.Muted)
+	}
+	if b.Label != "" {
+		pdf.CellFormat(0, 6, c.tr(b.Label), "", 1, "L", false, 0, "")
+	}
+	if contact != "" {
+		pdf.CellFormat(0, 6, c.tr(contact), "", 1, "L", false, 0, "")
+	}
+	if t.Banner {
+		pdf.SetY(44)
+	} else {
+		pdf.Ln(4)
+	}
+	if b.Summary != "" {
+		c.color(t.Text)
+		pdf.SetFont(t.Font, "", 10)
+		pdf.MultiCell(0, lineHeight, c.tr(b.Summary), "", "L", false)
+	}
+}
+
+func (c *canvas) section(title string) {
+	pdf, t := c.pdf, c.theme
+	pdf.Ln(4)
+	c.color(t.Accent)
+	pdf.SetFont(t.Font, "B", 13)
+	pdf.CellFormat(0, 7, c.tr(strings.ToUpper(title)), "", 1, "L", false, 0, "")
+	width, _ := pdf.GetPageSize()
+	pdf.SetDrawColor(t.Accent[0], t.Accent[1], t.Accent[2])
+	pdf.Line(pageMargin, pdf.GetY(), width-pageMargin, pdf.GetY())
+	pdf.Ln(2)
+}
+
+// entry renders a title with a subtitle, a right-aligned note and a body.
+func (c *canvas) entry(title, subtitle, note, body string) {
+	pdf, t := c.pdf, c.theme
+	c.color(t.Text)
+	pdf.SetFont(t.Font, "B", 11)
+	heading := title
+	if subtitle != "" {
+		heading += " - " + subtitle
+	}
+	y := pdf.GetY()
+	pdf.CellFormat(0, 6, c.tr(heading), "", 0, "L", false, 0, "")
+	if note != "" {
+		pdf.SetY(y)
+		c.color(t.Muted)
+		pdf.SetFont(t.Font, "I", 9)
+		pdf.CellFormat(0, 6, c.tr(note), "", 0, "R", false, 0, "")
+	}
+	pdf.Ln(6)
+	if body != "" {
+		c.color(t.Text)
+		pdf.SetFont(t.Font, "", 10)
+		pdf.MultiCell(0, lineHeight, c.tr(body), "", "L", false)
+	}
+	pdf.Ln(2)
+}
+
+func (c *canvas) skills(skills []dto.ResumeSkill) {
+	pdf, t := c.pdf, c.theme
+	var categories []string
+	byCategory := map[string][]string{}
+	for _, s := range skills {
+		category := "Other"
+		if len(s.Keywords) > 0 {
+			category = s.Keywords[0]
+		}
+		if _, ok := byCategory[category]; !ok {
+			categories = append(categories, category)
+		}
+		name := s.Name
+		if s.Level != "" {
+			name += " (" + s.Level + ")"
+		}
+		byCategory[category] = append(byCategory[category], name)
+	}
+	for _, category := range categories {
+		c.color(t.Text)
+		pdf.SetFont(t.Font, "B", 10)
+		label := c.tr(category + ": ")
+		pdf.CellFormat(pdf.GetStringWidth(label)+1, lineHeight, label, "", 0, "L", false, 0, "")
+		pdf.SetFont(t.Font, "", 10)
+		pdf.MultiCell(0, lineHeight, c.tr(strings.Join(byCategory[category], ", ")), "", "L", false)
+	}
+}
+
+// period formats JSON Resume dates as "Mar 2022 - Present".
+func period(start, end string) string {
+	format := func(s string) string {
+		d, err := model.ParseDate(s)
+		if err != nil || d.IsZero() {
+			return s
+		}
+		return d.Format("Jan 2006")
+	}
+	if end == "" {
+		return fmt.Sprintf("%s - Present", format(start))
+	}
+	return fmt.Sprintf("%s - %s", format(start), format(end))
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	var out []string
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, sep)
+}
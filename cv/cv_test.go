@@ -0,0 +1,63 @@
+package cv
+
+import (
+	"bytes"
+	"testing"
+
+	"porto/dto"
+)
+
+var resume = dto.Resume{
+	Basics: dto.ResumeBasics{Name: "Ann Café", Label: "Software engineer", Email: "ann@example.com", Summary: "Builds web services in Go."},
+	Work: []dto.ResumeWork{
+		{Name: "Acme", Position: "Engineer", StartDate: "2022-03-01", Summary: "Payments."},
+		{Name: "Initech", Position: "Intern", StartDate: "2020-01-01", EndDate: "2020-06-30"},
+	},
+	Projects: []dto.ResumeProject{{Name: "Shop", Description: "An online shop.", URL: "https://shop.example", Keywords: []string{"go"}}},
+	Skills:   []dto.ResumeSkill{{Name: "Go", Level: "expert", Keywords: []string{"Backend"}}, {Name: "SQL", Keywords: []string{"Backend"}}},
+}
+
+func TestRender(t *testing.T) {
+	for name, theme := range Themes {
+		var buf bytes.Buffer
+		if err := Render(&buf, resume, theme); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+			t.Errorf("%s: expected a PDF, got %q", name, buf.Bytes()[:min(buf.Len(), 16)])
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache()
+	first, etag, err := c.Get(resume, "modern")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, sameTag, _ := c.Get(resume, "modern")
+	if sameTag != etag || &again[0] != &first[0] {
+		t.Error("expected the cached PDF for unchanged data")
+	}
+
+	changed := resume
+	changed.Basics.Label = "Staff engineer"
+	if _, tag, _ := c.Get(changed, "modern"); tag == etag {
+		t.Error("expected a new ETag after the data changed")
+	}
+	if _, tag, _ := c.Get(resume, "classic"); tag == etag {
+		t.Error("expected themes to have different ETags")
+	}
+	if _, _, err := c.Get(resume, "neon"); err == nil {
+		t.Error("expected an error for an unknown theme")
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	if got := period("2022-03-01", ""); got != "Mar 2022 - Present" {
+		t.Errorf("got %q", got)
+	}
+	if got := period("2020-01-01", "2020-06-30"); got != "Jan 2020 - Jun 2020" {
+		t.Errorf("got %q", got)
+	}
+}
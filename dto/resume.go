@@ -0,0 +1,121 @@
+package dto
+
+import (
+	"fmt"
+
+	"porto/model"
+)
+
+// ResumeSchema is the JSON Resume schema the export conforms to.
+const ResumeSchema = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+// Resume is a document in the JSON Resume format (https://jsonresume.org).
+// Only the sections the site has data for are included.
+type Resume struct {
+	Schema   string          `json:"$schema,omitempty"`
+	Basics   ResumeBasics    `json:"basics"`
+	Work     []ResumeWork    `json:"work"`
+	Projects []ResumeProject `json:"projects"`
+	Skills   []ResumeSkill   `json:"skills"`
+}
+
+type ResumeBasics struct {
+	Name    string `json:"name"`
+	Label   string `json:"label,omitempty"`
+	Image   string `json:"image,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Phone   string `json:"phone,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// WithProfile returns b with the owner fields taken from p.
+func (b ResumeBasics) WithProfile(p model.Profile) ResumeBasics {
+	b.Name, b.Label, b.Image = p.Name, p.Headline, p.AvatarURL
+	b.Email, b.Phone, b.Summary = p.Email, p.Phone, p.Bio
+	return b
+}
+
+// ResumeWork is a position; Name is the company.
+type ResumeWork struct {
+	Name      string `json:"name"`
+	Position  string `json:"position"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate,omitempty"`
+	Summary   string `json:"summary,omitempty"`
+}
+
+// ResumeProject is a project. Slug is an extension of the format that
+// matches the project to the stored one on import.
+type ResumeProject struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	URL         string   `json:"url,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	Slug        string   `json:"x-slug,omitempty"`
+}
+
+// ResumeSkill is a single skill; its category is the only keyword.
+type ResumeSkill struct {
+	Name     string   `json:"name"`
+	Level    string   `json:"level,omitempty"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+func NewResume(basics ResumeBasics, exps []model.Experience, projects []model.Portfolio, skills []model.Skill) Resume {
+	r := Resume{
+		Schema:   ResumeSchema,
+		Basics:   basics,
+		Work:     make([]ResumeWork, len(exps)),
+		Projects: make([]ResumeProject, len(projects)),
+		Skills:   make([]ResumeSkill, len(skills)),
+	}
+	for i, e := range exps {
+		// An empty endDate means the position is current.
+		r.Work[i] = ResumeWork{Name: e.Company, Position: e.Title, StartDate: e.StartDate.String(), EndDate: e.EndDate.String(), Summary: e.Description}
+		if e.IsCurrent {
+			r.Work[i].EndDate = ""
+		}
+	}
+	for i, p := range projects {
+		r.Projects[i] = ResumeProject{Name: p.Name, Description: p.Description, URL: p.Link, Keywords: p.Tags, Slug: p.Slug}
+	}
+	for i, s := range skills {
+		r.Skills[i] = ResumeSkill{Name: s.Name, Level: s.Proficiency}
+		if s.Category != "" {
+			r.Skills[i].Keywords = []string{s.Category}
+		}
+	}
+	return r
+}
+
+// Experiences returns the work section as experiences; a position without
+// an end date is current.
+func (r Resume) Experiences() ([]model.Experience, error) {
+	exps := make([]model.Experience, len(r.Work))
+	for i, w := range r.Work {
+		start, err := model.ParseDate(w.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("work %d: %w", i, err)
+		}
+		end, err := model.ParseDate(w.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("work %d: %w", i, err)
+		}
+		exps[i] = model.Experience{
+			Title: w.Position, Company: w.Name, StartDate: start, EndDate: end,
+			IsCurrent: end.IsZero(), Description: w.Summary,
+		}
+	}
+	return exps, nil
+}
+
+// Portfolios returns the projects section. A project without a slug gets
+// one from the service.
+func (r Resume) Portfolios() []model.Portfolio {
+	projects := make([]model.Portfolio, len(r.Projects))
+	for i, p := range r.Projects {
+		projects[i] = model.Portfolio{Name: p.Name, Description: p.Description, Link: p.URL, Tags: p.Keywords, Slug: p.Slug}
+	}
+	return projects
+}
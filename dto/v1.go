@@ -0,0 +1,71 @@
+// Package dto defines the JSON shapes of each API version. Handlers map
+// model types to and from them so the model can change without changing
+// what existing clients receive.
+package dto
+
+import "porto/model"
+
+// ProjectV1 is a project as served by /api/v1 and the unversioned aliases.
+type ProjectV1 struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ImageURL    string `json:"image_url"`
+	Link        string `json:"link"`
+}
+
+func NewProjectV1(p model.Portfolio) ProjectV1 {
+	return ProjectV1{ID: p.ID, Name: p.Name, Description: p.Description, ImageURL: p.ImageURL, Link: p.Link}
+}
+
+func NewProjectsV1(ps []model.Portfolio) []ProjectV1 {
+	out := make([]ProjectV1, len(ps))
+	for i, p := range ps {
+		out[i] = NewProjectV1(p)
+	}
+	return out
+}
+
+// Apply copies the v1 fields onto p and leaves fields v1 does not know
+// about, such as the slug and tags, unchanged.
+func (d ProjectV1) Apply(p *model.Portfolio) {
+	p.ID = d.ID
+	p.Name = d.Name
+	p.Description = d.Description
+	p.ImageURL = d.ImageURL
+	p.Link = d.Link
+}
+
+// ExperienceV1 is an experience as served by /api/v1, with flat dates.
+type ExperienceV1 struct {
+	ID          int        `json:"id"`
+	Title       string     `json:"title"`
+	Company     string     `json:"company"`
+	StartDate   model.Date `json:"start_date"`
+	EndDate     model.Date `json:"end_date"`
+	IsCurrent   bool       `json:"is_current"`
+	Description string     `json:"description"`
+	Tenure      string     `json:"tenure,omitempty"`
+}
+
+func NewExperienceV1(e model.Experience) ExperienceV1 {
+	return ExperienceV1{
+		ID: e.ID, Title: e.Title, Company: e.Company, StartDate: e.StartDate, EndDate: e.EndDate,
+		IsCurrent: e.IsCurrent, Description: e.Description, Tenure: e.Tenure,
+	}
+}
+
+func NewExperiencesV1(es []model.Experience) []ExperienceV1 {
+	out := make([]ExperienceV1, len(es))
+	for i, e := range es {
+		out[i] = NewExperienceV1(e)
+	}
+	return out
+}
+
+func (d ExperienceV1) Model() model.Experience {
+	return model.Experience{
+		ID: d.ID, Title: d.Title, Company: d.Company, StartDate: d.StartDate, EndDate: d.EndDate,
+		IsCurrent: d.IsCurrent, Description: d.Description,
+	}
+}
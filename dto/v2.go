@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"time"
+
+	"porto/model"
+)
+
+// ProjectV2 adds the slug, tags and timestamps to ProjectV1.
+type ProjectV2 struct {
+	ID          int       `json:"id"`
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ImageURL    string    `json:"image_url"`
+	Link        string    `json:"link"`
+	Tags        []string  `json:"tags"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	// Translations override name and description per locale. Omitted on
+	// update, the stored translations are kept.
+	Translations model.Translations[model.PortfolioText] `json:"translations,omitempty"`
+}
+
+func NewProjectV2(p model.Portfolio) ProjectV2 {
+	tags := p.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return ProjectV2{
+		ID: p.ID, Slug: p.Slug, Name: p.Name, Description: p.Description, ImageURL: p.ImageURL,
+		Link: p.Link, Tags: tags, CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+		Translations: p.Translations,
+	}
+}
+
+func NewProjectsV2(ps []model.Portfolio) []ProjectV2 {
+	out := make([]ProjectV2, len(ps))
+	for i, p := range ps {
+		out[i] = NewProjectV2(p)
+	}
+	return out
+}
+
+// Model returns the project to store; the timestamps are set by the
+// database.
+func (d ProjectV2) Model() model.Portfolio {
+	return model.Portfolio{
+		ID: d.ID, Slug: d.Slug, Name: d.Name, Description: d.Description,
+		ImageURL: d.ImageURL, Link: d.Link, Tags: d.Tags, Translations: d.Translations,
+	}
+}
+
+// Period is when an experience took place. End is null while it is current.
+type Period struct {
+	Start   model.Date `json:"start"`
+	End     model.Date `json:"end"`
+	Current bool       `json:"current"`
+}
+
+// ExperienceV2 groups the dates of an experience into a period.
+type ExperienceV2 struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Company     string `json:"company"`
+	Period      Period `json:"period"`
+	Description string `json:"description"`
+	Tenure      string `json:"tenure,omitempty"`
+	// Translations override title and description per locale. Omitted on
+	// update, the stored translations are kept.
+	Translations model.Translations[model.ExperienceText] `json:"translations,omitempty"`
+}
+
+func NewExperienceV2(e model.Experience) ExperienceV2 {
+	return ExperienceV2{
+		ID: e.ID, Title: e.Title, Company: e.Company,
+		Period:      Period{Start: e.StartDate, End: e.EndDate, Current: e.IsCurrent},
+		Description: e.Description, Tenure: e.Tenure, Translations: e.Translations,
+	}
+}
+
+func NewExperiencesV2(es []model.Experience) []ExperienceV2 {
+	out := make([]ExperienceV2, len(es))
+	for i, e := range es {
+		out[i] = NewExperienceV2(e)
+	}
+	return out
+}
+
+func (d ExperienceV2) Model() model.Experience {
+	return model.Experience{
+		ID: d.ID, Title: d.Title, Company: d.Company,
+		StartDate: d.Period.Start, EndDate: d.Period.End, IsCurrent: d.Period.Current,
+		Description: d.Description, Translations: d.Translations,
+	}
+}
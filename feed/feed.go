@@ -0,0 +1,200 @@
+// Package feed builds Atom and RSS feeds of recent projects.
+package feed
+
+import (
+	"encoding/xml"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"porto/model"
+)
+
+// Limit is the number of entries in a feed.
+const Limit = 20
+
+// Site describes the feed owner. BaseURL, e.g. "https://example.com", makes
+// every link absolute.
+type Site struct {
+	Title   string
+	Author  string
+	BaseURL string
+}
+
+// WithProfile returns s with the author taken from p.
+func (s Site) WithProfile(p model.Profile) Site {
+	s.Author = p.Name
+	return s
+}
+
+// Recent returns up to Limit projects, newest first.
+func Recent(projects []model.Portfolio) []model.Portfolio {
+	projects = slices.Clone(projects)
+	slices.SortStableFunc(projects, func(a, b model.Portfolio) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return projects[:min(len(projects), Limit)]
+}
+
+// Updated is the latest change among the projects, or the zero time.
+func Updated(projects []model.Portfolio) time.Time {
+	var t time.Time
+	for _, p := range projects {
+		if p.UpdatedAt.After(t) {
+			t = p.UpdatedAt
+		}
+	}
+	return t
+}
+
+func (s Site) url(path string) string {
+	return strings.TrimRight(s.BaseURL, "/") + path
+}
+
+// ProjectURL is the detail page of a project.
+func (s Site) ProjectURL(p model.Portfolio) string {
+	return s.url("/portfolio/" + p.Slug)
+}
+
+// entryID is a tag URI (RFC 4151) that survives renames and slug changes.
+func (s Site) entryID(p model.Portfolio) string {
+	host := s.BaseURL
+	if u, err := url.Parse(s.BaseURL); err == nil && u.Host != "" {
+		host = u.Hostname()
+	}
+	return "tag:" + host + "," + p.CreatedAt.UTC().Format(time.DateOnly) + ":project/" + strconv.Itoa(p.ID)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  *atomPerson `xml:"author,omitempty"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Links      []atomLink     `xml:"link"`
+	Summary    string         `xml:"summary"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// Atom renders the projects, newest first, as an Atom 1.0 feed.
+func Atom(s Site, projects []model.Portfolio) ([]byte, error) {
+	f := atomFeed{
+		ID:      s.url("/"),
+		Title:   s.Title,
+		Updated: Updated(projects).UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: s.url("/feed.xml")},
+			{Rel: "alternate", Type: "text/html", Href: s.url("/portfolio")},
+		},
+	}
+	if s.Author != "" {
+		f.Author = &atomPerson{Name: s.Author}
+	}
+	for _, p := range projects {
+		e := atomEntry{
+			ID:        s.entryID(p),
+			Title:     p.Name,
+			Published: p.CreatedAt.UTC().Format(time.RFC3339),
+			Updated:   p.UpdatedAt.UTC().Format(time.RFC3339),
+			Links:     []atomLink{{Rel: "alternate", Type: "text/html", Href: s.ProjectURL(p)}},
+			Summary:   p.Description,
+		}
+		if p.Link != "" {
+			e.Links = append(e.Links, atomLink{Rel: "related", Href: p.Link})
+		}
+		for _, tag := range p.Tags {
+			e.Categories = append(e.Categories, atomCategory{Term: tag})
+		}
+		f.Entries = append(f.Entries, e)
+	}
+	return marshal(f)
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS renders the projects, newest first, as an RSS 2.0 feed.
+func RSS(s Site, projects []model.Portfolio) ([]byte, error) {
+	f := rss{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       s.Title,
+			Link:        s.url("/portfolio"),
+			Description: "Recent projects",
+			Self:        atomLink{Rel: "self", Type: "application/rss+xml", Href: s.url("/rss.xml")},
+		},
+	}
+	if updated := Updated(projects); !updated.IsZero() {
+		f.Channel.LastBuildDate = updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, p := range projects {
+		f.Channel.Items = append(f.Channel.Items, rssItem{
+			Title:       p.Name,
+			Link:        s.ProjectURL(p),
+			GUID:        rssGUID{Value: s.entryID(p)},
+			PubDate:     p.CreatedAt.UTC().Format(time.RFC1123Z),
+			Description: p.Description,
+			Categories:  p.Tags,
+		})
+	}
+	return marshal(f)
+}
+
+func marshal(v any) ([]byte, error) {
+	b, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
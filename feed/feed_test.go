@@ -0,0 +1,73 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"porto/model"
+)
+
+var site = Site{Title: "New projects", Author: "Ann", BaseURL: "https://example.com/"}
+
+var projects = []model.Portfolio{
+	{ID: 1, Slug: "blog", Name: "Blog", Description: "A blog", CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)},
+	{ID: 2, Slug: "shop", Name: "Shop & more", Description: "A shop", Link: "https://shop.example", Tags: []string{"go"},
+		CreatedAt: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)},
+}
+
+func TestRecent(t *testing.T) {
+	got := Recent(projects)
+	if len(got) != 2 || got[0].Slug != "shop" {
+		t.Errorf("expected the newest project first, got %+v", got)
+	}
+	if projects[0].Slug != "blog" {
+		t.Error("expected the input to be left unchanged")
+	}
+	if u := Updated(projects); !u.Equal(projects[0].UpdatedAt) {
+		t.Errorf("expected the latest update, got %v", u)
+	}
+}
+
+func TestAtom(t *testing.T) {
+	b, err := Atom(site, Recent(projects))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f atomFeed
+	if err := xml.Unmarshal(b, &f); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, b)
+	}
+	if f.ID != "https://example.com/" || f.Updated != "2025-05-01T00:00:00Z" || len(f.Entries) != 2 {
+		t.Fatalf("unexpected feed: %+v", f)
+	}
+	e := f.Entries[0]
+	if e.ID != "tag:example.com,2025-03-04:project/2" || e.Title != "Shop & more" || e.Links[0].Href != "https://example.com/portfolio/shop" {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+	if len(e.Links) != 2 || len(e.Categories) != 1 {
+		t.Errorf("expected the project link and tag, got %+v", e)
+	}
+}
+
+func TestRSS(t *testing.T) {
+	b, err := RSS(site, Recent(projects))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f rss
+	if err := xml.Unmarshal(b, &f); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, b)
+	}
+	items := f.Channel.Items
+	if len(items) != 2 || items[0].GUID.Value != "tag:example.com,2025-03-04:project/2" || items[0].GUID.IsPermaLink {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+	if items[0].PubDate != "Tue, 04 Mar 2025 00:00:00 +0000" {
+		t.Errorf("unexpected pubDate %q", items[0].PubDate)
+	}
+	if !strings.Contains(string(b), `<atom:link rel="self" type="application/rss+xml" href="https://example.com/rss.xml">`) {
+		t.Errorf("expected a self link, got\n%s", b)
+	}
+}
@@ -0,0 +1,60 @@
+// Package gql serves the GraphQL API. Resolvers call the service layer, so
+// queries and mutations share the validation of the REST API.
+package gql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"porto/middleware"
+	"porto/service"
+)
+
+//go:embed schema.graphql
+var schema string
+
+// maxPageSize caps first on paginated fields. It is also the parallelism
+// limit, so a full page of nested fields lands in a single loader batch.
+const maxPageSize = 100
+
+// ErrForbidden is returned by admin fields without a valid admin token.
+var ErrForbidden = errors.New("admin token required")
+
+// Services are the dependencies of the resolvers.
+type Services struct {
+	Portfolio  service.PortfolioService
+	Experience service.ExperienceService
+	Skill      service.SkillService
+	Contact    service.ContactService
+}
+
+type adminKey struct{}
+
+// NewHandler serves GraphQL requests. Contacts and mutations need an
+// "Authorization: Bearer <adminToken>" header; an empty adminToken disables
+// them.
+func NewHandler(s Services, adminToken string) http.Handler {
+	h := &relay.Handler{Schema: graphql.MustParseSchema(schema, &Resolver{s},
+		graphql.MaxDepth(10),
+		graphql.MaxParallelism(maxPageSize),
+	)}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := withLoaders(r.Context(), newLoaders(s))
+		if middleware.IsAdmin(r, adminToken) {
+			ctx = context.WithValue(ctx, adminKey{}, true)
+		}
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func requireAdmin(ctx context.Context) error {
+	if admin, _ := ctx.Value(adminKey{}).(bool); !admin {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package gql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"porto/model"
+	"porto/spam"
+)
+
+type mockPortfolioService struct {
+	GetAllFunc func(ctx context.Context) ([]model.Portfolio, error)
+	CreateFunc func(ctx context.Context, p *model.Portfolio) error
+}
+
+func (m *mockPortfolioService) GetAll(ctx context.Context) ([]model.Portfolio, error) {
+	return m.GetAllFunc(ctx)
+}
+func (m *mockPortfolioService) GetByID(ctx context.Context, id int) (*model.Portfolio, error) {
+	return nil, nil
+}
+func (m *mockPortfolioService) GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error) {
+	return nil, nil
+}
+func (m *mockPortfolioService) Create(ctx context.Context, p *model.Portfolio) error {
+	return m.CreateFunc(ctx, p)
+}
+func (m *mockPortfolioService) Update(ctx context.Context, _ *model.Portfolio) error { return nil }
+func (m *mockPortfolioService) Delete(ctx context.Context, _ int) error              { return nil }
+
+type mockSkillService struct {
+	GetAllFunc func(ctx context.Context) ([]model.Skill, error)
+}
+
+func (m *mockSkillService) GetAll(ctx context.Context) ([]model.Skill, error) {
+	return m.GetAllFunc(ctx)
+}
+func (m *mockSkillService) GetByID(ctx context.Context, id int) (*model.Skill, error) {
+	return nil, nil
+}
+func (m *mockSkillService) Create(ctx context.Context, _ *model.Skill) error { return nil }
+func (m *mockSkillService) Update(ctx context.Context, _ *model.Skill) error { return nil }
+func (m *mockSkillService) Delete(ctx context.Context, _ int) error          { return nil }
+func (m *mockSkillService) GetUsage(ctx context.Context, _ string) ([]model.SkillUsage, error) {
+	return nil, nil
+}
+
+type mockContactService struct {
+	GetAllFunc func(ctx context.Context, status string) ([]model.Contact, error)
+}
+
+func (m *mockContactService) GetAll(ctx context.Context, status string) ([]model.Contact, error) {
+	return m.GetAllFunc(ctx, status)
+}
+func (m *mockContactService) GetByID(ctx context.Context, id int) (*model.Contact, error) {
+	return nil, nil
+}
+func (m *mockContactService) Create(ctx context.Context, _ *model.Contact) error { return nil }
+func (m *mockContactService) Submit(ctx context.Context, _ *model.Contact, _ spam.Submission) error {
+	return nil
+}
+func (m *mockContactService) FormToken() string                                       { return "" }
+func (m *mockContactService) UpdateStatus(ctx context.Context, _ int, _ string) error { return nil }
+func (m *mockContactService) BulkUpdateStatus(ctx context.Context, _ []int, _ string) (int, error) {
+	return 0, nil
+}
+func (m *mockContactService) UpdateNotes(ctx context.Context, _ int, _ string) error { return nil }
+func (m *mockContactService) Counts(ctx context.Context) (*model.ContactCounts, error) {
+	return nil, nil
+}
+func (m *mockContactService) Delete(ctx context.Context, _ int) error { return nil }
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func query(t *testing.T, h http.Handler, token, q string) response {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": q})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	var resp response
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid response %s: %v", rr.Body.String(), err)
+	}
+	return resp
+}
+
+func TestProjects_BatchesSkills(t *testing.T) {
+	var skillCalls atomic.Int32
+	h := NewHandler(Services{
+		Portfolio: &mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) {
+			return []model.Portfolio{
+				{ID: 3, Name: "Shop", Slug: "shop", Tags: []string{"go"}},
+				{ID: 1, Name: "Blog", Slug: "blog", Tags: []string{"go", "web"}},
+				{ID: 2, Name: "CLI", Slug: "cli"},
+			}, nil
+		}},
+		Skill: &mockSkillService{GetAllFunc: func(ctx context.Context) ([]model.Skill, error) {
+			skillCalls.Add(1)
+			return []model.Skill{
+				{ID: 10, Name: "Go", PortfolioIDs: []int{1, 2, 3}},
+				{ID: 11, Name: "SQL", PortfolioIDs: []int{1}},
+			}, nil
+		}},
+	}, "")
+
+	resp := query(t, h, "", `{ projects(first: 2) { totalCount pageInfo { hasNextPage endCursor } edges { node { slug skills { name } } } } }`)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+	var data struct {
+		Projects struct {
+			TotalCount int
+			PageInfo   struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+			Edges []struct {
+				Node struct {
+					Slug   string
+					Skills []struct{ Name string }
+				}
+			}
+		}
+	}
+	json.Unmarshal(resp.Data, &data)
+	p := data.Projects
+	if p.TotalCount != 3 || !p.PageInfo.HasNextPage || len(p.Edges) != 2 {
+		t.Fatalf("unexpected page: %+v", p)
+	}
+	if p.Edges[0].Node.Slug != "blog" || len(p.Edges[0].Node.Skills) != 2 || len(p.Edges[1].Node.Skills) != 1 {
+		t.Errorf("unexpected edges: %+v", p.Edges)
+	}
+	if n := skillCalls.Load(); n != 1 {
+		t.Errorf("expected skills to be loaded once, got %d calls", n)
+	}
+
+	resp = query(t, h, "", `{ projects(after: "`+p.PageInfo.EndCursor+`") { edges { node { slug } } pageInfo { hasNextPage } } }`)
+	if len(resp.Errors) > 0 || !bytes.Contains(resp.Data, []byte(`"shop"`)) || !bytes.Contains(resp.Data, []byte(`"hasNextPage":false`)) {
+		t.Errorf("unexpected second page: %s %+v", resp.Data, resp.Errors)
+	}
+
+	resp = query(t, h, "", `{ projects(tag: "Web") { totalCount } }`)
+	if !bytes.Contains(resp.Data, []byte(`"totalCount":1`)) {
+		t.Errorf("expected the tag filter to match one project, got %s", resp.Data)
+	}
+}
+
+func TestContacts_RequireAdmin(t *testing.T) {
+	h := NewHandler(Services{
+		Contact: &mockContactService{GetAllFunc: func(ctx context.Context, status string) ([]model.Contact, error) {
+			return []model.Contact{{ID: 1, Name: "Ann", Status: status}}, nil
+		}},
+	}, "secret")
+
+	for _, token := range []string{"", "wrong"} {
+		resp := query(t, h, token, `{ contacts { totalCount } }`)
+		if len(resp.Errors) != 1 || resp.Errors[0].Message != ErrForbidden.Error() {
+			t.Errorf("token %q: expected forbidden, got %+v", token, resp.Errors)
+		}
+	}
+
+	resp := query(t, h, "secret", `{ contacts(status: "new") { edges { node { name status } } } }`)
+	if len(resp.Errors) > 0 || !bytes.Contains(resp.Data, []byte(`"status":"new"`)) {
+		t.Errorf("unexpected response: %s %+v", resp.Data, resp.Errors)
+	}
+}
+
+func TestCreateProject_ReturnsServiceErrors(t *testing.T) {
+	var got model.Portfolio
+	h := NewHandler(Services{
+		Portfolio: &mockPortfolioService{CreateFunc: func(ctx context.Context, p *model.Portfolio) error {
+			got = *p
+			return errors.New("name is required")
+		}},
+	}, "secret")
+
+	resp := query(t, h, "secret", `mutation { createProject(input: {name: "", description: "d", tags: ["go"]}) { id } }`)
+	if len(resp.Errors) != 1 || resp.Errors[0].Message != "name is required" {
+		t.Errorf("expected the validation error, got %+v", resp.Errors)
+	}
+	if got.Description != "d" || len(got.Tags) != 1 {
+		t.Errorf("unexpected project passed to the service: %+v", got)
+	}
+}
+
+func TestPaginate_HugeCursor(t *testing.T) {
+	after := encodeCursor(math.MaxInt)
+	c, err := paginate([]int{1, 2, 3}, pageArgs{First: 10, After: &after}, func(i int) int { return i })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(c.edges) != 0 || c.hasNext {
+		t.Errorf("expected an empty last page, got %d edges, hasNext %v", len(c.edges), c.hasNext)
+	}
+}
@@ -0,0 +1,77 @@
+package gql
+
+import (
+	"context"
+	"slices"
+
+	"github.com/graph-gophers/dataloader/v7"
+
+	"porto/model"
+)
+
+// loaders batch the lookups made by nested fields, so a list of projects
+// with their skills costs one skill query instead of one per project. They
+// cache for the lifetime of a single request.
+type loaders struct {
+	skillsByProject    *dataloader.Loader[int, []model.Skill]
+	skillsByExperience *dataloader.Loader[int, []model.Skill]
+	project            *dataloader.Loader[int, *model.Portfolio]
+}
+
+type loadersKey struct{}
+
+func newLoaders(s Services) *loaders {
+	return &loaders{
+		skillsByProject:    dataloader.NewBatchedLoader(skillsBy(s, func(sk model.Skill) []int { return sk.PortfolioIDs })),
+		skillsByExperience: dataloader.NewBatchedLoader(skillsBy(s, func(sk model.Skill) []int { return sk.ExperienceIDs })),
+		project:            dataloader.NewBatchedLoader(projectsByID(s)),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// skillsBy loads every skill once per batch and groups them by the ids
+// returned by linked.
+func skillsBy(s Services, linked func(model.Skill) []int) dataloader.BatchFunc[int, []model.Skill] {
+	return func(ctx context.Context, ids []int) []*dataloader.Result[[]model.Skill] {
+		results := make([]*dataloader.Result[[]model.Skill], len(ids))
+		skills, err := s.Skill.GetAll(ctx)
+		for i, id := range ids {
+			if err != nil {
+				results[i] = &dataloader.Result[[]model.Skill]{Error: err}
+				continue
+			}
+			matched := []model.Skill{}
+			for _, sk := range skills {
+				if slices.Contains(linked(sk), id) {
+					matched = append(matched, sk)
+				}
+			}
+			results[i] = &dataloader.Result[[]model.Skill]{Data: matched}
+		}
+		return results
+	}
+}
+
+// projectsByID resolves unknown ids to nil rather than an error, since a
+// skill may still reference a deleted project.
+func projectsByID(s Services) dataloader.BatchFunc[int, *model.Portfolio] {
+	return func(ctx context.Context, ids []int) []*dataloader.Result[*model.Portfolio] {
+		results := make([]*dataloader.Result[*model.Portfolio], len(ids))
+		projects, err := s.Portfolio.GetAll(ctx)
+		byID := make(map[int]*model.Portfolio, len(projects))
+		for i := range projects {
+			byID[projects[i].ID] = &projects[i]
+		}
+		for i, id := range ids {
+			results[i] = &dataloader.Result[*model.Portfolio]{Data: byID[id], Error: err}
+		}
+		return results
+	}
+}
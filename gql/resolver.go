@@ -0,0 +1,244 @@
+package gql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"porto/model"
+)
+
+// Resolver is the root of the schema; its methods are the Query and
+// Mutation fields.
+type Resolver struct {
+	s Services
+}
+
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+func (r *Resolver) Projects(ctx context.Context, args struct {
+	Tag    *string
+	Search *string
+	First  int32
+	After  *string
+}) (*connection[*projectResolver], error) {
+	projects, err := r.s.Portfolio.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(projects, func(a, b model.Portfolio) int { return a.ID - b.ID })
+	projects = slices.DeleteFunc(projects, func(p model.Portfolio) bool {
+		if args.Tag != nil && !slices.Contains(p.Tags, strings.ToLower(*args.Tag)) {
+			return true
+		}
+		if args.Search != nil {
+			q := strings.ToLower(*args.Search)
+			return !strings.Contains(strings.ToLower(p.Name), q) && !strings.Contains(strings.ToLower(p.Description), q)
+		}
+		return false
+	})
+	return paginate(projects, pageArgs{args.First, args.After}, func(p model.Portfolio) *projectResolver { return &projectResolver{p} })
+}
+
+// Project returns null when no project has the slug.
+func (r *Resolver) Project(ctx context.Context, args struct{ Slug string }) (*projectResolver, error) {
+	p, err := r.s.Portfolio.GetBySlug(ctx, args.Slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &projectResolver{*p}, nil
+}
+
+func (r *Resolver) Experiences(ctx context.Context, args struct{ Current *bool }) ([]*experienceResolver, error) {
+	exps, err := r.s.Experience.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := []*experienceResolver{}
+	for _, e := range exps {
+		if args.Current == nil || e.IsCurrent == *args.Current {
+			out = append(out, &experienceResolver{e})
+		}
+	}
+	return out, nil
+}
+
+func (r *Resolver) Skills(ctx context.Context, args struct{ Category *string }) ([]*skillResolver, error) {
+	skills, err := r.s.Skill.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := []*skillResolver{}
+	for _, sk := range skills {
+		if args.Category == nil || strings.EqualFold(sk.Category, *args.Category) {
+			out = append(out, &skillResolver{sk})
+		}
+	}
+	return out, nil
+}
+
+func (r *Resolver) Contacts(ctx context.Context, args struct {
+	Status *string
+	First  int32
+	After  *string
+}) (*connection[*contactResolver], error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var status string
+	if args.Status != nil {
+		status = *args.Status
+	}
+	contacts, err := r.s.Contact.GetAll(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	return paginate(contacts, pageArgs{args.First, args.After}, func(c model.Contact) *contactResolver { return &contactResolver{c} })
+}
+
+type projectInput struct {
+	Name        string
+	Description string
+	ImageURL    *string
+	Link        *string
+	Slug        *string
+	Tags        *[]string
+}
+
+// apply copies the input onto p, keeping the fields that were left out.
+func (in projectInput) apply(p *model.Portfolio) {
+	p.Name = in.Name
+	p.Description = in.Description
+	if in.ImageURL != nil {
+		p.ImageURL = *in.ImageURL
+	}
+	if in.Link != nil {
+		p.Link = *in.Link
+	}
+	if in.Slug != nil {
+		p.Slug = *in.Slug
+	}
+	if in.Tags != nil {
+		p.Tags = *in.Tags
+	}
+}
+
+func (r *Resolver) CreateProject(ctx context.Context, args struct{ Input projectInput }) (*projectResolver, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var p model.Portfolio
+	args.Input.apply(&p)
+	if err := r.s.Portfolio.Create(ctx, &p); err != nil {
+		return nil, err
+	}
+	return &projectResolver{p}, nil
+}
+
+func (r *Resolver) UpdateProject(ctx context.Context, args struct {
+	Slug  string
+	Input projectInput
+}) (*projectResolver, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := r.findProject(ctx, args.Slug)
+	if err != nil {
+		return nil, err
+	}
+	args.Input.apply(p)
+	if err := r.s.Portfolio.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	return &projectResolver{*p}, nil
+}
+
+func (r *Resolver) DeleteProject(ctx context.Context, args struct{ Slug string }) (bool, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+	p, err := r.findProject(ctx, args.Slug)
+	if err != nil {
+		return false, err
+	}
+	if err := r.s.Portfolio.Delete(ctx, p.ID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *Resolver) findProject(ctx context.Context, slug string) (*model.Portfolio, error) {
+	p, err := r.s.Portfolio.GetBySlug(ctx, slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("project %q not found", slug)
+	}
+	return p, err
+}
+
+type experienceInput struct {
+	Title       string
+	Company     string
+	StartDate   string
+	EndDate     *string
+	IsCurrent   *bool
+	Description *string
+}
+
+func (r *Resolver) CreateExperience(ctx context.Context, args struct{ Input experienceInput }) (*experienceResolver, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	in := args.Input
+	e := model.Experience{Title: in.Title, Company: in.Company}
+	var err error
+	if e.StartDate, err = model.ParseDate(in.StartDate); err != nil {
+		return nil, err
+	}
+	if in.EndDate != nil {
+		if e.EndDate, err = model.ParseDate(*in.EndDate); err != nil {
+			return nil, err
+		}
+	}
+	if in.IsCurrent != nil {
+		e.IsCurrent = *in.IsCurrent
+	}
+	if in.Description != nil {
+		e.Description = *in.Description
+	}
+	if err := r.s.Experience.Create(ctx, &e); err != nil {
+		return nil, err
+	}
+	return &experienceResolver{e}, nil
+}
+
+func (r *Resolver) UpdateContactStatus(ctx context.Context, args struct {
+	ID     graphql.ID
+	Status string
+}) (bool, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return false, err
+	}
+	id, err := strconv.Atoi(string(args.ID))
+	if err != nil {
+		return false, fmt.Errorf("invalid contact id %q", args.ID)
+	}
+	if err := r.s.Contact.UpdateStatus(ctx, id, args.Status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("contact %d not found", id)
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,192 @@
+package gql
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"porto/model"
+)
+
+type projectResolver struct{ p model.Portfolio }
+
+func (r *projectResolver) ID() graphql.ID          { return intID(r.p.ID) }
+func (r *projectResolver) Slug() string            { return r.p.Slug }
+func (r *projectResolver) Name() string            { return r.p.Name }
+func (r *projectResolver) Description() string     { return r.p.Description }
+func (r *projectResolver) ImageURL() string        { return r.p.ImageURL }
+func (r *projectResolver) Link() string            { return r.p.Link }
+func (r *projectResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.p.CreatedAt} }
+func (r *projectResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.p.UpdatedAt} }
+
+func (r *projectResolver) Tags() []string {
+	if r.p.Tags == nil {
+		return []string{}
+	}
+	return r.p.Tags
+}
+
+func (r *projectResolver) Skills(ctx context.Context) ([]*skillResolver, error) {
+	skills, err := loadersFrom(ctx).skillsByProject.Load(ctx, r.p.ID)()
+	return skillResolvers(skills), err
+}
+
+type experienceResolver struct{ e model.Experience }
+
+func (r *experienceResolver) ID() graphql.ID      { return intID(r.e.ID) }
+func (r *experienceResolver) Title() string       { return r.e.Title }
+func (r *experienceResolver) Company() string     { return r.e.Company }
+func (r *experienceResolver) StartDate() string   { return r.e.StartDate.String() }
+func (r *experienceResolver) IsCurrent() bool     { return r.e.IsCurrent }
+func (r *experienceResolver) Description() string { return r.e.Description }
+func (r *experienceResolver) Tenure() string      { return r.e.Tenure }
+
+func (r *experienceResolver) EndDate() *string {
+	if r.e.EndDate.IsZero() {
+		return nil
+	}
+	end := r.e.EndDate.String()
+	return &end
+}
+
+func (r *experienceResolver) Skills(ctx context.Context) ([]*skillResolver, error) {
+	skills, err := loadersFrom(ctx).skillsByExperience.Load(ctx, r.e.ID)()
+	return skillResolvers(skills), err
+}
+
+type skillResolver struct{ s model.Skill }
+
+func (r *skillResolver) ID() graphql.ID      { return intID(r.s.ID) }
+func (r *skillResolver) Name() string        { return r.s.Name }
+func (r *skillResolver) Category() string    { return r.s.Category }
+func (r *skillResolver) Proficiency() string { return r.s.Proficiency }
+func (r *skillResolver) Years() int32        { return int32(r.s.Years) }
+
+// Projects skips ids that no longer resolve to a project.
+func (r *skillResolver) Projects(ctx context.Context) ([]*projectResolver, error) {
+	thunks := make([]func() (*model.Portfolio, error), len(r.s.PortfolioIDs))
+	for i, id := range r.s.PortfolioIDs {
+		thunks[i] = loadersFrom(ctx).project.Load(ctx, id)
+	}
+	out := []*projectResolver{}
+	for _, thunk := range thunks {
+		p, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			out = append(out, &projectResolver{*p})
+		}
+	}
+	return out, nil
+}
+
+func skillResolvers(skills []model.Skill) []*skillResolver {
+	out := make([]*skillResolver, len(skills))
+	for i, sk := range skills {
+		out[i] = &skillResolver{sk}
+	}
+	return out
+}
+
+type contactResolver struct{ c model.Contact }
+
+func (r *contactResolver) ID() graphql.ID          { return intID(r.c.ID) }
+func (r *contactResolver) Name() string            { return r.c.Name }
+func (r *contactResolver) Email() string           { return r.c.Email }
+func (r *contactResolver) Subject() string         { return r.c.Subject }
+func (r *contactResolver) Message() string         { return r.c.Message }
+func (r *contactResolver) Status() string          { return r.c.Status }
+func (r *contactResolver) Notes() string           { return r.c.Notes }
+func (r *contactResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.c.CreatedAt} }
+
+func intID(id int) graphql.ID {
+	return graphql.ID(strconv.Itoa(id))
+}
+
+// connection is a page of a Relay-style connection. Cursors are opaque
+// offsets into the filtered list.
+type connection[T any] struct {
+	edges   []*edge[T]
+	total   int
+	hasNext bool
+}
+
+type edge[T any] struct {
+	cursor string
+	node   T
+}
+
+func (c *connection[T]) Edges() []*edge[T] { return c.edges }
+func (c *connection[T]) TotalCount() int32 { return int32(c.total) }
+
+func (c *connection[T]) PageInfo() *pageInfo {
+	info := &pageInfo{hasNext: c.hasNext}
+	if len(c.edges) > 0 {
+		info.end = &c.edges[len(c.edges)-1].cursor
+	}
+	return info
+}
+
+func (e *edge[T]) Cursor() string { return e.cursor }
+func (e *edge[T]) Node() T        { return e.node }
+
+type pageInfo struct {
+	hasNext bool
+	end     *string
+}
+
+func (p *pageInfo) HasNextPage() bool  { return p.hasNext }
+func (p *pageInfo) EndCursor() *string { return p.end }
+
+// paginate returns up to first items after the cursor, at most maxPageSize.
+func paginate[M, T any](items []M, args pageArgs, node func(M) T) (*connection[T], error) {
+	if args.First < 0 {
+		return nil, fmt.Errorf("first must not be negative")
+	}
+	first := min(int(args.First), maxPageSize)
+	start := 0
+	if args.After != nil {
+		offset, err := decodeCursor(*args.After)
+		if err != nil {
+			return nil, err
+		}
+		// Compare before adding one so a huge cursor cannot overflow.
+		if offset >= len(items) {
+			start = len(items)
+		} else {
+			start = offset + 1
+		}
+	}
+	end := min(start+first, len(items))
+	c := &connection[T]{total: len(items), hasNext: end < len(items)}
+	for i := start; i < end; i++ {
+		c.edges = append(c.edges, &edge[T]{cursor: encodeCursor(i), node: node(items[i])})
+	}
+	if c.edges == nil {
+		c.edges = []*edge[T]{}
+	}
+	return c, nil
+}
+
+const cursorPrefix = "offset:"
+
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.StdEncoding.DecodeString(cursor)
+	if err == nil {
+		if s, ok := strings.CutPrefix(string(b), cursorPrefix); ok {
+			if offset, err := strconv.Atoi(s); err == nil && offset >= 0 {
+				return offset, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid cursor %q", cursor)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+	"porto/model"
+	"porto/service"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ClientHandler struct {
+	Service service.ClientService
+}
+
+func NewClientHandler(s service.ClientService) *ClientHandler {
+	return &ClientHandler{Service: s}
+}
+
+func (h *ClientHandler) GetClients(w http.ResponseWriter, r *http.Request) {
+	clients, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetClients error", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "GetClients success", "component", "ClientHandler", "count", len(clients))
+	json.NewEncoder(w).Encode(clients)
+}
+
+func (h *ClientHandler) CreateClient(w http.ResponseWriter, r *http.Request) {
+	var c model.Client
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		slog.WarnContext(r.Context(), "CreateClient decode error", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Create(r.Context(), &c); err != nil {
+		slog.ErrorContext(r.Context(), "CreateClient service error", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "CreateClient success", "component", "ClientHandler", "id", c.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(c)
+}
+
+func (h *ClientHandler) UpdateClient(w http.ResponseWriter, r *http.Request) {
+	var c model.Client
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		slog.WarnContext(r.Context(), "UpdateClient decode error", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Update(r.Context(), &c); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateClient service error", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateClient success", "component", "ClientHandler", "id", c.ID)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(c)
+}
+
+// ReorderClients takes {"ids": [3, 1, 2]} and displays clients in that order.
+func (h *ClientHandler) ReorderClients(w http.ResponseWriter, r *http.Request) {
+	writeReorder(w, r, "ReorderClients", "ClientHandler", h.Service.Reorder)
+}
+
+func (h *ClientHandler) DeleteClient(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "DeleteClient invalid id", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Delete(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "DeleteClient service error", "component", "ClientHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "DeleteClient success", "component", "ClientHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -1,97 +1,256 @@
 package handler
 
 import (
+	"bytes"
+	"database/sql"
 	"encoding/json"
-	"html/template"
-	"log"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
-	"path/filepath"
+	"porto/i18n"
+	"porto/middleware"
 	"porto/model"
+	"porto/seo"
 	"porto/service"
+	"porto/spam"
+	"porto/validation"
 	"strconv"
 
-	"github.com/gorilla/mux"
+	"github.com/go-chi/chi/v5"
 )
 
 type ContactHandler struct {
-	Service service.ContactService
+	Service     service.ContactService
+	TemplateDir string
+	// Site feeds the title and share tags of the contact page.
+	Site           seo.Site
+	ProfileService service.ProfileService
 }
 
-func NewContactHandler(s service.ContactService) *ContactHandler {
-	return &ContactHandler{Service: s}
+func NewContactHandler(s service.ContactService, ps service.ProfileService, site seo.Site, templateDir string) *ContactHandler {
+	return &ContactHandler{Service: s, ProfileService: ps, Site: site, TemplateDir: templateDir}
 }
 
+// GetContacts lists the inbox, filtered by ?status=.
 func (h *ContactHandler) GetContacts(w http.ResponseWriter, r *http.Request) {
-	contacts, err := h.Service.GetAll(r.Context())
+	status := r.URL.Query().Get("status")
+	if status != "" {
+		if err := validation.ValidateContactStatus(status); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(i18n.Error(r.Context(), err)))
+			return
+		}
+	}
+	contacts, err := h.Service.GetAll(r.Context(), status)
 	if err != nil {
-		log.Printf("[ContactHandler] GetContacts error: %v", err)
+		slog.ErrorContext(r.Context(), "GetContacts error", "component", "ContactHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	log.Printf("[ContactHandler] GetContacts success, count: %d", len(contacts))
+	slog.InfoContext(r.Context(), "GetContacts success", "component", "ContactHandler", "count", len(contacts))
 	json.NewEncoder(w).Encode(contacts)
 }
 
+// CreateContact is the public submission endpoint. Besides the contact
+// fields it reads the "website" honeypot and the optional "form_token"
+// issued by GetContactFormToken. The response is the stored contact, as
+// before the spam checks.
 func (h *ContactHandler) CreateContact(w http.ResponseWriter, r *http.Request) {
-	var c model.Contact
-	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
-		log.Printf("[ContactHandler] CreateContact decode error: %v", err)
+	var body struct {
+		model.Contact
+		Website   string `json:"website"`
+		FormToken string `json:"form_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		slog.WarnContext(r.Context(), "CreateContact decode error", "component", "ContactHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
-	if err := h.Service.Create(r.Context(), &c); err != nil {
-		log.Printf("[ContactHandler] CreateContact service error: %v", err)
-		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(err.Error()))
+	c := body.Contact
+	sub := spam.Submission{IP: clientIP(r), Honeypot: body.Website, Token: body.FormToken}
+	if err := h.Service.Submit(r.Context(), &c, sub); err != nil {
+		slog.ErrorContext(r.Context(), "CreateContact service error", "component", "ContactHandler", "error", err)
+		if errors.Is(err, spam.ErrRateLimited) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		w.Write([]byte(i18n.Error(r.Context(), err)))
 		return
 	}
-	log.Printf("[ContactHandler] CreateContact success: %+v", c)
+	slog.InfoContext(r.Context(), "CreateContact success", "component", "ContactHandler", "id", c.ID)
+	// Spam is accepted like any other message so senders learn nothing.
+	c.Status = model.ContactNew
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(c)
 }
 
+// GetContactFormToken returns the token API clients send as "form_token".
+func (h *ContactHandler) GetContactFormToken(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(map[string]string{"form_token": h.Service.FormToken()})
+}
+
+func (h *ContactHandler) GetContact(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "GetContact invalid id", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c, err := h.Service.GetByID(r.Context(), id)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetContact error", "component", "ContactHandler", "error", err)
+		writeContactError(w, r, err)
+		return
+	}
+	json.NewEncoder(w).Encode(c)
+}
+
+// GetContactCounts returns the unread count and totals per status.
+func (h *ContactHandler) GetContactCounts(w http.ResponseWriter, r *http.Request) {
+	counts, err := h.Service.Counts(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetContactCounts error", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(counts)
+}
+
+// UpdateContactStatus expects {"status": "read"}.
+func (h *ContactHandler) UpdateContactStatus(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "UpdateContactStatus invalid id", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var body struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		slog.WarnContext(r.Context(), "UpdateContactStatus decode error", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.UpdateStatus(r.Context(), id, body.Status); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateContactStatus service error", "component", "ContactHandler", "error", err)
+		writeContactError(w, r, err)
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateContactStatus success", "component", "ContactHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// BulkUpdateContactStatus expects {"ids": [1, 2], "status": "archived"}.
+func (h *ContactHandler) BulkUpdateContactStatus(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		IDs    []int  `json:"ids"`
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		slog.WarnContext(r.Context(), "BulkUpdateContactStatus decode error", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	n, err := h.Service.BulkUpdateStatus(r.Context(), body.IDs, body.Status)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "BulkUpdateContactStatus service error", "component", "ContactHandler", "error", err)
+		writeContactError(w, r, err)
+		return
+	}
+	slog.InfoContext(r.Context(), "BulkUpdateContactStatus success", "component", "ContactHandler", "updated", n)
+	json.NewEncoder(w).Encode(map[string]int{"updated": n})
+}
+
+// UpdateContactNotes expects {"notes": "..."}.
+func (h *ContactHandler) UpdateContactNotes(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "UpdateContactNotes invalid id", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var body struct {
+		Notes string `json:"notes"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		slog.WarnContext(r.Context(), "UpdateContactNotes decode error", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.UpdateNotes(r.Context(), id, body.Notes); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateContactNotes service error", "component", "ContactHandler", "error", err)
+		writeContactError(w, r, err)
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateContactNotes success", "component", "ContactHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeContactError maps unknown ids to 404 and anything else to 400.
+func writeContactError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write([]byte(i18n.Error(r.Context(), err)))
+}
+
 func (h *ContactHandler) DeleteContact(w http.ResponseWriter, r *http.Request) {
-	idStr := mux.Vars(r)["id"]
+	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		log.Printf("[ContactHandler] DeleteContact invalid id: %v", err)
+		slog.WarnContext(r.Context(), "DeleteContact invalid id", "component", "ContactHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 	if err := h.Service.Delete(r.Context(), id); err != nil {
-		log.Printf("[ContactHandler] DeleteContact service error: %v", err)
+		slog.ErrorContext(r.Context(), "DeleteContact service error", "component", "ContactHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	log.Printf("[ContactHandler] DeleteContact success, id: %d", id)
+	slog.InfoContext(r.Context(), "DeleteContact success", "component", "ContactHandler", "id", id)
 	w.WriteHeader(http.StatusNoContent)
 }
 
 type ContactPageData struct {
-	Address      string
-	AddressDetail string
-	Phone        string
-	PhoneDesc    string
-	Email        string
-	EmailDesc    string
-	Form         struct {
+	Title     string
+	Profile   model.Profile
+	PhoneDesc string
+	EmailDesc string
+	Form      struct {
 		Name    string
 		Email   string
 		Subject string
 		Message string
 	}
+	CSRFToken   string
+	FormToken   string
 	FormMessage string
 	Static      string
+	Meta        seo.Meta
 }
 
+// RenderContactPage shows the contact form and handles its submission. A
+// successful post redirects back to /contact?sent=1 (post/redirect/get) so a
+// refresh does not send the message again; a failed one re-renders the form
+// with the entered values.
 func (h *ContactHandler) RenderContactPage(w http.ResponseWriter, r *http.Request) {
+	profile, err := loadProfile(r, h.ProfileService)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "RenderContactPage profile error", "component", "ContactHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	locale := i18n.Locale(r.Context())
 	data := ContactPageData{
-		Address:      "California, United States",
-		AddressDetail: "Santa monica bullevard",
-		Phone:        "00 (440) 9865 562",
-		PhoneDesc:    "Mon to Fri 9am to 6 pm",
-		Email:        "support@colorlib.com",
-		EmailDesc:    "Send us your query anytime!",
+		Title:     i18n.T(locale, "contact.title"),
+		Profile:   profile,
+		PhoneDesc: i18n.T(locale, "contact.phone_desc"),
+		EmailDesc: i18n.T(locale, "contact.email_desc"),
 	}
 	// Ambil path static dari header, env, atau default
 	staticPath := r.Header.Get("X-Static-Path")
@@ -99,6 +258,10 @@ func (h *ContactHandler) RenderContactPage(w http.ResponseWriter, r *http.Reques
 		staticPath = "/static"
 	}
 	data.Static = staticPath
+	data.Meta = h.Site.WithProfile(profile).In(locale).Page("/contact", data.Title, i18n.T(locale, "contact.description"))
+	data.CSRFToken = middleware.CSRFToken(r)
+	data.FormToken = h.Service.FormToken()
+	status := http.StatusOK
 	if r.Method == http.MethodPost {
 		r.ParseForm()
 		data.Form.Name = r.FormValue("name")
@@ -108,31 +271,46 @@ func (h *ContactHandler) RenderContactPage(w http.ResponseWriter, r *http.Reques
 		contact := model.Contact{
 			Name:    data.Form.Name,
 			Email:   data.Form.Email,
+			Subject: data.Form.Subject,
 			Message: data.Form.Message,
 		}
-		err := h.Service.Create(r.Context(), &contact)
-		if err != nil {
-			data.FormMessage = err.Error()
-		} else {
-			data.FormMessage = "Pesan berhasil dikirim!"
-			data.Form = struct {
-				Name    string
-				Email   string
-				Subject string
-				Message string
-			}{} // reset form
+		sub := spam.Submission{IP: clientIP(r), Honeypot: r.FormValue("website"), Token: r.FormValue("form_token"), RequireToken: true}
+		err := h.Service.Submit(r.Context(), &contact, sub)
+		if err == nil {
+			http.Redirect(w, r, "/contact?sent=1", http.StatusSeeOther)
+			return
+		}
+		data.FormMessage = i18n.Error(r.Context(), err)
+		status = http.StatusBadRequest
+		if errors.Is(err, spam.ErrRateLimited) {
+			status = http.StatusTooManyRequests
 		}
+	} else if r.URL.Query().Get("sent") != "" {
+		data.FormMessage = i18n.T(locale, "contact.sent")
 	}
-	tmplPath := filepath.Join("WebView", "contact.html")
-	tmpl, err := template.ParseFiles(tmplPath)
+	tmpl, err := parsePage(h.TemplateDir, "contact", locale)
 	if err != nil {
+		slog.ErrorContext(r.Context(), "template parse error", "component", "ContactHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte("Template error: " + err.Error()))
 		return
 	}
-	err = tmpl.Execute(w, data)
-	if err != nil {
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "contact.html", data); err != nil {
+		slog.ErrorContext(r.Context(), "template execute error", "component", "ContactHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte("Render error: " + err.Error()))
+		return
+	}
+	w.WriteHeader(status)
+	buf.WriteTo(w)
+}
+
+// clientIP returns the host part of the request's remote address.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
+	return host
 }
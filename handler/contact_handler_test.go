@@ -3,22 +3,33 @@ package handler
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
+	"porto/i18n"
 	"porto/model"
+	"porto/seo"
+	"porto/spam"
+
+	"github.com/go-chi/chi/v5"
 )
 
 type mockContactService struct {
-	GetAllFunc func(ctx context.Context) ([]model.Contact, error)
-	CreateFunc func(ctx context.Context, c *model.Contact) error
+	GetAllFunc           func(ctx context.Context, status string) ([]model.Contact, error)
+	CreateFunc           func(ctx context.Context, c *model.Contact) error
+	SubmitFunc           func(ctx context.Context, c *model.Contact, sub spam.Submission) error
+	UpdateStatusFunc     func(ctx context.Context, id int, status string) error
+	BulkUpdateStatusFunc func(ctx context.Context, ids []int, status string) (int, error)
 }
 
-func (m *mockContactService) GetAll(ctx context.Context) ([]model.Contact, error) {
-	return m.GetAllFunc(ctx)
+func (m *mockContactService) GetAll(ctx context.Context, status string) ([]model.Contact, error) {
+	return m.GetAllFunc(ctx, status)
 }
 func (m *mockContactService) GetByID(ctx context.Context, id int) (*model.Contact, error) {
 	return nil, nil
@@ -26,15 +37,32 @@ func (m *mockContactService) GetByID(ctx context.Context, id int) (*model.Contac
 func (m *mockContactService) Create(ctx context.Context, c *model.Contact) error {
 	return m.CreateFunc(ctx, c)
 }
+func (m *mockContactService) Submit(ctx context.Context, c *model.Contact, sub spam.Submission) error {
+	if m.SubmitFunc != nil {
+		return m.SubmitFunc(ctx, c, sub)
+	}
+	return m.CreateFunc(ctx, c)
+}
+func (m *mockContactService) FormToken() string { return "token" }
+func (m *mockContactService) UpdateStatus(ctx context.Context, id int, status string) error {
+	return m.UpdateStatusFunc(ctx, id, status)
+}
+func (m *mockContactService) BulkUpdateStatus(ctx context.Context, ids []int, status string) (int, error) {
+	return m.BulkUpdateStatusFunc(ctx, ids, status)
+}
+func (m *mockContactService) UpdateNotes(ctx context.Context, _ int, _ string) error { return nil }
+func (m *mockContactService) Counts(ctx context.Context) (*model.ContactCounts, error) {
+	return &model.ContactCounts{}, nil
+}
 func (m *mockContactService) Delete(ctx context.Context, _ int) error { return nil }
 
 func TestContactHandler_GetContacts(t *testing.T) {
 	svc := &mockContactService{
-		GetAllFunc: func(ctx context.Context) ([]model.Contact, error) {
+		GetAllFunc: func(ctx context.Context, status string) ([]model.Contact, error) {
 			return []model.Contact{{ID: 1, Name: "A"}}, nil
 		},
 	}
-	h := NewContactHandler(svc)
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
 	r := httptest.NewRequest(http.MethodGet, "/api/contacts", nil)
 	w := httptest.NewRecorder()
 
@@ -53,7 +81,7 @@ func TestContactHandler_CreateContact(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewContactHandler(svc)
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
 	body, _ := json.Marshal(model.Contact{Name: "A", Email: "a@mail.com", Message: "hi"})
 	r := httptest.NewRequest(http.MethodPost, "/api/contacts", bytes.NewReader(body))
 	w := httptest.NewRecorder()
@@ -66,11 +94,11 @@ func TestContactHandler_CreateContact(t *testing.T) {
 
 func TestContactHandler_GetContacts_Error(t *testing.T) {
 	svc := &mockContactService{
-		GetAllFunc: func(ctx context.Context) ([]model.Contact, error) {
+		GetAllFunc: func(ctx context.Context, status string) ([]model.Contact, error) {
 			return nil, errors.New("db error")
 		},
 	}
-	h := NewContactHandler(svc)
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
 	r := httptest.NewRequest(http.MethodGet, "/api/contacts", nil)
 	w := httptest.NewRecorder()
 
@@ -86,7 +114,7 @@ func TestContactHandler_CreateContact_BadRequest(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewContactHandler(svc)
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
 	r := httptest.NewRequest(http.MethodPost, "/api/contacts", bytes.NewReader([]byte("notjson")))
 	w := httptest.NewRecorder()
 
@@ -102,7 +130,7 @@ func TestContactHandler_CreateContact_ServiceError(t *testing.T) {
 			return errors.New("service error")
 		},
 	}
-	h := NewContactHandler(svc)
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
 	body, _ := json.Marshal(model.Contact{Name: "A", Email: "a@mail.com", Message: "hi"})
 	r := httptest.NewRequest(http.MethodPost, "/api/contacts", bytes.NewReader(body))
 	w := httptest.NewRecorder()
@@ -112,3 +140,152 @@ func TestContactHandler_CreateContact_ServiceError(t *testing.T) {
 		t.Errorf("expected 400, got %d", w.Code)
 	}
 }
+
+func TestContactHandler_UpdateContactStatus(t *testing.T) {
+	svc := &mockContactService{
+		UpdateStatusFunc: func(ctx context.Context, id int, status string) error {
+			if id != 1 {
+				return sql.ErrNoRows
+			}
+			if status != model.ContactRead {
+				return errors.New("bad status")
+			}
+			return nil
+		},
+	}
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
+	router := chi.NewRouter()
+	router.Put("/api/contacts/{id}/status", h.UpdateContactStatus)
+
+	cases := []struct {
+		path, body string
+		want       int
+	}{
+		{"/api/contacts/1/status", `{"status":"read"}`, http.StatusNoContent},
+		{"/api/contacts/2/status", `{"status":"read"}`, http.StatusNotFound},
+		{"/api/contacts/1/status", `{"status":"gone"}`, http.StatusBadRequest},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, c.path, bytes.NewBufferString(c.body)))
+		if w.Code != c.want {
+			t.Errorf("%s %s: expected %d, got %d", c.path, c.body, c.want, w.Code)
+		}
+	}
+}
+
+func TestContactHandler_BulkUpdateContactStatus(t *testing.T) {
+	svc := &mockContactService{
+		BulkUpdateStatusFunc: func(ctx context.Context, ids []int, status string) (int, error) {
+			return len(ids), nil
+		},
+	}
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
+	r := httptest.NewRequest(http.MethodPut, "/api/contacts/status", bytes.NewBufferString(`{"ids":[1,2],"status":"archived"}`))
+	w := httptest.NewRecorder()
+
+	h.BulkUpdateContactStatus(w, r)
+	var got map[string]int
+	json.NewDecoder(w.Body).Decode(&got)
+	if w.Code != http.StatusOK || got["updated"] != 2 {
+		t.Errorf("expected 2 updated, got %d %v", w.Code, got)
+	}
+}
+
+func TestContactHandler_CreateContact_Spam(t *testing.T) {
+	var got spam.Submission
+	svc := &mockContactService{
+		SubmitFunc: func(ctx context.Context, c *model.Contact, sub spam.Submission) error {
+			got = sub
+			if sub.IP == "9.9.9.9" {
+				return spam.ErrRateLimited
+			}
+			c.ID, c.Status = 3, model.ContactSpam
+			return nil
+		},
+	}
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
+	body := `{"name":"A","email":"a@mail.com","message":"hi","website":"x","form_token":"t"}`
+	r := httptest.NewRequest(http.MethodPost, "/api/contacts", bytes.NewBufferString(body))
+	r.RemoteAddr = "1.2.3.4:5678"
+	w := httptest.NewRecorder()
+
+	h.CreateContact(w, r)
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected 201, got %d", w.Code)
+	}
+	if got.IP != "1.2.3.4" || got.Honeypot != "x" || got.Token != "t" {
+		t.Errorf("expected submission details to be passed on, got %+v", got)
+	}
+	var created model.Contact
+	json.NewDecoder(w.Body).Decode(&created)
+	if created.ID != 3 || created.Name != "A" || created.Status != model.ContactNew {
+		t.Errorf("expected the contact without its spam status, got %+v", created)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/api/contacts", bytes.NewBufferString(body))
+	r.RemoteAddr = "9.9.9.9:1"
+	w = httptest.NewRecorder()
+	h.CreateContact(w, r)
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected 429, got %d", w.Code)
+	}
+}
+
+func TestContactHandler_RenderContactPage(t *testing.T) {
+	var got model.Contact
+	svc := &mockContactService{
+		CreateFunc: func(ctx context.Context, c *model.Contact) error {
+			got = *c
+			if c.Name == "" {
+				return errors.New("contact name is required")
+			}
+			return nil
+		},
+	}
+	h := NewContactHandler(svc, noProfile, seo.Site{}, "../WebView")
+
+	// GET renders the form with both tokens
+	w := httptest.NewRecorder()
+	h.RenderContactPage(w, httptest.NewRequest(http.MethodGet, "/contact", nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `name="form_token" value="token"`) {
+		t.Fatalf("expected form to render, got %d", w.Code)
+	}
+
+	// successful post redirects
+	form := url.Values{"name": {"A"}, "email": {"a@mail.com"}, "subject": {"Hi"}, "message": {"hello"}}
+	r := httptest.NewRequest(http.MethodPost, "/contact", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	h.RenderContactPage(w, r)
+	if w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/contact?sent=1" {
+		t.Errorf("expected redirect to /contact?sent=1, got %d %q", w.Code, w.Header().Get("Location"))
+	}
+	if got.Subject != "Hi" {
+		t.Errorf("expected subject to be stored, got %+v", got)
+	}
+
+	// failed post re-renders with the entered values
+	form.Set("name", "")
+	r = httptest.NewRequest(http.MethodPost, "/contact", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	h.RenderContactPage(w, r)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "contact name is required") || !strings.Contains(w.Body.String(), "a@mail.com") {
+		t.Errorf("expected form to be re-rendered with an error, got %d", w.Code)
+	}
+}
+
+func TestContactHandler_RenderContactPage_Localized(t *testing.T) {
+	h := NewContactHandler(&mockContactService{}, noProfile, seo.Site{}, "../WebView")
+	r := httptest.NewRequest(http.MethodGet, "/contact?sent=1", nil)
+	w := httptest.NewRecorder()
+	h.RenderContactPage(w, r.WithContext(i18n.WithLocale(r.Context(), "id")))
+
+	body := w.Body.String()
+	for _, want := range []string{"Pesan berhasil dikirim!", "<h2>Hubungi Kami</h2>", `placeholder="Masukkan nama Anda"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in the page", want)
+		}
+	}
+}
@@ -2,13 +2,14 @@ package handler
 
 import (
 	"encoding/json"
-	"log"
+	"log/slog"
 	"net/http"
-	"porto/model"
+	"porto/dto"
+	"porto/i18n"
 	"porto/service"
 	"strconv"
 
-	"github.com/gorilla/mux"
+	"github.com/go-chi/chi/v5"
 )
 
 type ExperienceHandler struct {
@@ -22,63 +23,65 @@ func NewExperienceHandler(s service.ExperienceService) *ExperienceHandler {
 func (h *ExperienceHandler) GetExperiences(w http.ResponseWriter, r *http.Request) {
 	exps, err := h.Service.GetAll(r.Context())
 	if err != nil {
-		log.Printf("[ExperienceHandler] GetExperiences error: %v", err)
+		slog.ErrorContext(r.Context(), "GetExperiences error", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	log.Printf("[ExperienceHandler] GetExperiences success, count: %d", len(exps))
-	json.NewEncoder(w).Encode(exps)
+	slog.InfoContext(r.Context(), "GetExperiences success", "component", "ExperienceHandler", "count", len(exps))
+	json.NewEncoder(w).Encode(dto.NewExperiencesV1(exps))
 }
 
 func (h *ExperienceHandler) CreateExperience(w http.ResponseWriter, r *http.Request) {
-	var e model.Experience
-	if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
-		log.Printf("[ExperienceHandler] CreateExperience decode error: %v", err)
+	var d dto.ExperienceV1
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "CreateExperience decode error", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	e := d.Model()
 	if err := h.Service.Create(r.Context(), &e); err != nil {
-		log.Printf("[ExperienceHandler] CreateExperience service error: %v", err)
+		slog.ErrorContext(r.Context(), "CreateExperience service error", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(err.Error()))
+		w.Write([]byte(i18n.Error(r.Context(), err)))
 		return
 	}
-	log.Printf("[ExperienceHandler] CreateExperience success: %+v", e)
+	slog.InfoContext(r.Context(), "CreateExperience success", "component", "ExperienceHandler", "id", e.ID)
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(e)
+	json.NewEncoder(w).Encode(dto.NewExperienceV1(e))
 }
 
 func (h *ExperienceHandler) UpdateExperience(w http.ResponseWriter, r *http.Request) {
-	var e model.Experience
-	if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
-		log.Printf("[ExperienceHandler] UpdateExperience decode error: %v", err)
+	var d dto.ExperienceV1
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "UpdateExperience decode error", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	e := d.Model()
 	if err := h.Service.Update(r.Context(), &e); err != nil {
-		log.Printf("[ExperienceHandler] UpdateExperience service error: %v", err)
+		slog.ErrorContext(r.Context(), "UpdateExperience service error", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(err.Error()))
+		w.Write([]byte(i18n.Error(r.Context(), err)))
 		return
 	}
-	log.Printf("[ExperienceHandler] UpdateExperience success: %+v", e)
+	slog.InfoContext(r.Context(), "UpdateExperience success", "component", "ExperienceHandler", "id", e.ID)
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(e)
+	json.NewEncoder(w).Encode(dto.NewExperienceV1(e))
 }
 
 func (h *ExperienceHandler) DeleteExperience(w http.ResponseWriter, r *http.Request) {
-	idStr := mux.Vars(r)["id"]
+	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		log.Printf("[ExperienceHandler] DeleteExperience invalid id: %v", err)
+		slog.WarnContext(r.Context(), "DeleteExperience invalid id", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 	if err := h.Service.Delete(r.Context(), id); err != nil {
-		log.Printf("[ExperienceHandler] DeleteExperience service error: %v", err)
+		slog.ErrorContext(r.Context(), "DeleteExperience service error", "component", "ExperienceHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	log.Printf("[ExperienceHandler] DeleteExperience success, id: %d", id)
+	slog.InfoContext(r.Context(), "DeleteExperience success", "component", "ExperienceHandler", "id", id)
 	w.WriteHeader(http.StatusNoContent)
 }
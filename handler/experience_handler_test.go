@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"porto/model"
 )
@@ -55,7 +56,7 @@ func TestExperienceHandler_CreateExperience(t *testing.T) {
 		},
 	}
 	h := NewExperienceHandler(svc)
-	body, _ := json.Marshal(model.Experience{Title: "A", Company: "B", StartDate: "2020"})
+	body, _ := json.Marshal(model.Experience{Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)})
 	r := httptest.NewRequest(http.MethodPost, "/api/experiences", bytes.NewReader(body))
 	w := httptest.NewRecorder()
 
@@ -104,7 +105,7 @@ func TestExperienceHandler_CreateExperience_ServiceError(t *testing.T) {
 		},
 	}
 	h := NewExperienceHandler(svc)
-	body, _ := json.Marshal(model.Experience{Title: "A", Company: "B", StartDate: "2020"})
+	body, _ := json.Marshal(model.Experience{Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)})
 	r := httptest.NewRequest(http.MethodPost, "/api/experiences", bytes.NewReader(body))
 	w := httptest.NewRecorder()
 
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/dto"
+	"porto/i18n"
+)
+
+// The v2 experience endpoints group the dates into a period object.
+
+func (h *ExperienceHandler) GetExperiencesV2(w http.ResponseWriter, r *http.Request) {
+	exps, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetExperiencesV2 error", "component", "ExperienceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(dto.NewExperiencesV2(exps))
+}
+
+func (h *ExperienceHandler) CreateExperienceV2(w http.ResponseWriter, r *http.Request) {
+	var d dto.ExperienceV2
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "CreateExperienceV2 decode error", "component", "ExperienceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	e := d.Model()
+	if err := h.Service.Create(r.Context(), &e); err != nil {
+		slog.ErrorContext(r.Context(), "CreateExperienceV2 service error", "component", "ExperienceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "CreateExperienceV2 success", "component", "ExperienceHandler", "id", e.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.NewExperienceV2(e))
+}
+
+func (h *ExperienceHandler) UpdateExperienceV2(w http.ResponseWriter, r *http.Request) {
+	var d dto.ExperienceV2
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "UpdateExperienceV2 decode error", "component", "ExperienceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	e := d.Model()
+	if err := h.Service.Update(r.Context(), &e); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateExperienceV2 service error", "component", "ExperienceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateExperienceV2 success", "component", "ExperienceHandler", "id", e.ID)
+	json.NewEncoder(w).Encode(dto.NewExperienceV2(e))
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"porto/feed"
+	"porto/model"
+	"porto/service"
+)
+
+// FeedHandler serves the recent projects as Atom and RSS feeds.
+type FeedHandler struct {
+	Service        service.PortfolioService
+	ProfileService service.ProfileService
+	// Site is the feed owner; the author comes from the profile.
+	Site feed.Site
+}
+
+func NewFeedHandler(s service.PortfolioService, ps service.ProfileService, site feed.Site) *FeedHandler {
+	return &FeedHandler{Service: s, ProfileService: ps, Site: site}
+}
+
+func (h *FeedHandler) GetAtom(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "application/atom+xml; charset=utf-8", feed.Atom)
+}
+
+func (h *FeedHandler) GetRSS(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "application/rss+xml; charset=utf-8", feed.RSS)
+}
+
+// serve answers conditional requests with 304 through the ETag, a hash of
+// the feed, and Last-Modified, the latest project update.
+func (h *FeedHandler) serve(w http.ResponseWriter, r *http.Request, contentType string, render func(feed.Site, []model.Portfolio) ([]byte, error)) {
+	projects, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Feed projects error", "component", "FeedHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	profile, err := loadProfile(r, h.ProfileService)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Feed profile error", "component", "FeedHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	recent := feed.Recent(projects)
+	body, err := render(h.Site.WithProfile(profile), recent)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "Feed render error", "component", "FeedHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	sum := sha256.Sum256(body)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", "no-cache")
+	http.ServeContent(w, r, "", feed.Updated(recent), bytes.NewReader(body))
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"porto/feed"
+	"porto/model"
+)
+
+func TestFeedHandler_ConditionalGet(t *testing.T) {
+	updated := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+	h := NewFeedHandler(&mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) {
+		return []model.Portfolio{{ID: 1, Slug: "shop", Name: "Shop", CreatedAt: updated, UpdatedAt: updated}}, nil
+	}}, &mockProfileService{GetFunc: testProfile}, feed.Site{Title: "Projects", BaseURL: "https://example.com"})
+
+	for path, handle := range map[string]http.HandlerFunc{"/feed.xml": h.GetAtom, "/rss.xml": h.GetRSS} {
+		w := httptest.NewRecorder()
+		handle(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "https://example.com/portfolio/shop") {
+			t.Fatalf("%s: expected the feed, got %d %s", path, w.Code, w.Body.String())
+		}
+		if path == "/feed.xml" && !strings.Contains(w.Body.String(), "<name>Jane Doe</name>") {
+			t.Errorf("%s: expected the profile name as the author", path)
+		}
+		if w.Header().Get("Last-Modified") != "Tue, 04 Mar 2025 10:00:00 GMT" {
+			t.Errorf("%s: unexpected Last-Modified %q", path, w.Header().Get("Last-Modified"))
+		}
+
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.Header.Set("If-None-Match", w.Header().Get("ETag"))
+		w = httptest.NewRecorder()
+		handle(w, r)
+		if w.Code != http.StatusNotModified {
+			t.Errorf("%s: expected 304 for a matching ETag, got %d", path, w.Code)
+		}
+
+		r = httptest.NewRequest(http.MethodGet, path, nil)
+		r.Header.Set("If-Modified-Since", "Wed, 05 Mar 2025 00:00:00 GMT")
+		w = httptest.NewRecorder()
+		handle(w, r)
+		if w.Code != http.StatusNotModified {
+			t.Errorf("%s: expected 304 when not modified since, got %d", path, w.Code)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+	"porto/middleware"
+	"porto/model"
+	"porto/seo"
+	"porto/service"
+)
+
+// HomeHandler renders the landing page, which pulls together several resources.
+type HomeHandler struct {
+	ServiceService     service.ServiceService
+	TestimonialService service.TestimonialService
+	ClientService      service.ClientService
+	TemplateDir        string
+	// Site feeds the title, share tags and structured data of the page.
+	Site           seo.Site
+	ProfileService service.ProfileService
+}
+
+func NewHomeHandler(ss service.ServiceService, ts service.TestimonialService, cs service.ClientService, ps service.ProfileService, site seo.Site, templateDir string) *HomeHandler {
+	return &HomeHandler{ServiceService: ss, TestimonialService: ts, ClientService: cs, ProfileService: ps, Site: site, TemplateDir: templateDir}
+}
+
+type HomePageData struct {
+	Services     []model.Service
+	Testimonials []model.Testimonial
+	Clients      []model.Client
+	Profile      model.Profile
+	CSRFToken    string
+	Meta         seo.Meta
+}
+
+func (h *HomeHandler) RenderHomePage(w http.ResponseWriter, r *http.Request) {
+	var data HomePageData
+	var err error
+	if data.Services, err = h.ServiceService.GetAll(r.Context()); err != nil {
+		slog.ErrorContext(r.Context(), "RenderHomePage services error", "component", "HomeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if data.Testimonials, err = h.TestimonialService.GetApproved(r.Context()); err != nil {
+		slog.ErrorContext(r.Context(), "RenderHomePage testimonials error", "component", "HomeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if data.Clients, err = h.ClientService.GetAll(r.Context()); err != nil {
+		slog.ErrorContext(r.Context(), "RenderHomePage clients error", "component", "HomeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if data.Profile, err = loadProfile(r, h.ProfileService); err != nil {
+		slog.ErrorContext(r.Context(), "RenderHomePage profile error", "component", "HomeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	data.CSRFToken = middleware.CSRFToken(r)
+	locale := i18n.Locale(r.Context())
+	site := h.Site.WithProfile(data.Profile).In(locale)
+	data.Meta = site.Page("/", site.Name, "")
+	data.Meta.JSONLD = []any{site.PersonLD()}
+	tmpl, err := parsePage(h.TemplateDir, "home", locale)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "template parse error", "component", "HomeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	err = tmpl.Execute(w, data)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "template execute error", "component", "HomeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
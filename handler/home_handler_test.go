@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"porto/middleware"
+	"porto/model"
+	"porto/seo"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type mockClientService struct {
+	GetAllFunc func(ctx context.Context) ([]model.Client, error)
+}
+
+func (m *mockClientService) GetAll(ctx context.Context) ([]model.Client, error) {
+	return m.GetAllFunc(ctx)
+}
+func (m *mockClientService) GetByID(ctx context.Context, id int) (*model.Client, error) {
+	return nil, nil
+}
+func (m *mockClientService) Create(ctx context.Context, _ *model.Client) error { return nil }
+func (m *mockClientService) Update(ctx context.Context, _ *model.Client) error { return nil }
+func (m *mockClientService) Delete(ctx context.Context, _ int) error           { return nil }
+func (m *mockClientService) Reorder(ctx context.Context, _ []int) error        { return nil }
+
+func newTestHomeHandler(clients func(ctx context.Context) ([]model.Client, error)) *HomeHandler {
+	ss := &mockServiceService{GetAllFunc: func(ctx context.Context) ([]model.Service, error) {
+		return []model.Service{{Title: "Go consulting"}}, nil
+	}}
+	ts := &mockTestimonialService{GetApprovedFunc: func(ctx context.Context) ([]model.Testimonial, error) {
+		return nil, nil
+	}}
+	return NewHomeHandler(ss, ts, &mockClientService{GetAllFunc: clients}, noProfile, seo.Site{}, "../WebView")
+}
+
+func TestHomeHandler_RenderHomePage(t *testing.T) {
+	h := newTestHomeHandler(func(ctx context.Context) ([]model.Client, error) {
+		return []model.Client{{ID: 1, Name: "Acme", Website: "https://acme.test", LogoURL: "/uploads/acme.png"}}, nil
+	})
+	w := httptest.NewRecorder()
+	h.RenderHomePage(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, `src="/uploads/acme.png"`) || strings.Contains(body, "img/brands/logo1.png") {
+		t.Error("expected brand strip to be rendered from clients")
+	}
+	if !strings.Contains(body, "Go consulting") {
+		t.Error("expected services to be rendered")
+	}
+}
+
+func TestHomeHandler_RenderHomePage_Error(t *testing.T) {
+	h := newTestHomeHandler(func(ctx context.Context) ([]model.Client, error) {
+		return nil, errors.New("db error")
+	})
+	w := httptest.NewRecorder()
+	h.RenderHomePage(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
+
+func TestHomeHandler_NewsletterFormPostsBack(t *testing.T) {
+	var subscribed string
+	subscriber := NewSubscriberHandler(&mockSubscriberService{SubscribeFunc: func(ctx context.Context, email string) error {
+		subscribed = email
+		return nil
+	}}, noProfile, "../WebView")
+	r := chi.NewRouter()
+	r.Use(middleware.CSRF)
+	r.Get("/", newTestHomeHandler(func(ctx context.Context) ([]model.Client, error) { return nil, nil }).RenderHomePage)
+	r.Post("/newsletter/subscribe", subscriber.Subscribe)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	m := regexp.MustCompile(`<form action="/newsletter/subscribe" method="post"[^>]*>\s*<input type="hidden" name="csrf_token" value="([0-9a-f]+)">`).FindStringSubmatch(w.Body.String())
+	if m == nil {
+		t.Fatal("expected the newsletter form to carry a csrf_token field")
+	}
+
+	// post the form back with the cookie the page set, as a browser without
+	// scripts would
+	form := url.Values{"csrf_token": {m[1]}, "email": {"a@mail.com"}}
+	req := httptest.NewRequest(http.MethodPost, "/newsletter/subscribe", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, c := range w.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusSeeOther || subscribed != "a@mail.com" {
+		t.Errorf("expected the subscription to be accepted, got %d for %q", w.Code, subscribed)
+	}
+}
+
+func TestCheckTemplates(t *testing.T) {
+	if err := CheckTemplates("../WebView"); err != nil {
+		t.Errorf("expected templates to parse, got %v", err)
+	}
+	if err := CheckTemplates(t.TempDir()); err == nil {
+		t.Error("expected an error for a directory without templates")
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"porto/service"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type JobHandler struct {
+	Service service.JobService
+}
+
+func NewJobHandler(s service.JobService) *JobHandler {
+	return &JobHandler{Service: s}
+}
+
+// GetJobs lists recent background jobs for admins, filtered by ?status=.
+func (h *JobHandler) GetJobs(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.Service.GetAll(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetJobs error", "component", "JobHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	slog.InfoContext(r.Context(), "GetJobs success", "component", "JobHandler", "count", len(jobs))
+	json.NewEncoder(w).Encode(jobs)
+}
+
+// RetryJob re-queues a dead job.
+func (h *JobHandler) RetryJob(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "RetryJob invalid id", "component", "JobHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Retry(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "RetryJob error", "component", "JobHandler", "error", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "RetryJob success", "component", "JobHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"porto/model"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type mockJobService struct {
+	RetryFunc func(ctx context.Context, id int) error
+}
+
+func (m *mockJobService) Enqueue(ctx context.Context, kind string, payload any) error { return nil }
+func (m *mockJobService) GetAll(ctx context.Context, status string) ([]model.Job, error) {
+	return nil, nil
+}
+func (m *mockJobService) Retry(ctx context.Context, id int) error { return m.RetryFunc(ctx, id) }
+
+func TestJobHandler_RetryJob(t *testing.T) {
+	svc := &mockJobService{
+		RetryFunc: func(ctx context.Context, id int) error {
+			if id != 1 {
+				return sql.ErrNoRows
+			}
+			return nil
+		},
+	}
+	h := NewJobHandler(svc)
+	router := chi.NewRouter()
+	router.Post("/api/admin/jobs/{id}/retry", h.RetryJob)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/admin/jobs/1/retry", nil))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/admin/jobs/2/retry", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", w.Code)
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/service"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type MediaHandler struct {
+	Service service.MediaService
+}
+
+func NewMediaHandler(s service.MediaService) *MediaHandler {
+	return &MediaHandler{Service: s}
+}
+
+func (h *MediaHandler) GetMedia(w http.ResponseWriter, r *http.Request) {
+	media, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetMedia error", "component", "MediaHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "GetMedia success", "component", "MediaHandler", "count", len(media))
+	json.NewEncoder(w).Encode(media)
+}
+
+// UploadMedia accepts a multipart form with the image in the "file" field.
+func (h *MediaHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, service.MaxMediaSize+1<<20)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		slog.WarnContext(r.Context(), "UploadMedia form error", "component", "MediaHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("file is required"))
+		return
+	}
+	defer file.Close()
+	m, err := h.Service.Upload(r.Context(), header.Filename, file)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "UploadMedia service error", "component", "MediaHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	slog.InfoContext(r.Context(), "UploadMedia success", "component", "MediaHandler", "id", m.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(m)
+}
+
+func (h *MediaHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "DeleteMedia invalid id", "component", "MediaHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Delete(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "DeleteMedia service error", "component", "MediaHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "DeleteMedia success", "component", "MediaHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"porto/model"
+)
+
+type mockMediaService struct {
+	UploadFunc func(ctx context.Context, fileName string, r io.Reader) (*model.Media, error)
+}
+
+func (m *mockMediaService) GetAll(ctx context.Context) ([]model.Media, error) { return nil, nil }
+func (m *mockMediaService) GetByID(ctx context.Context, id int) (*model.Media, error) {
+	return nil, nil
+}
+func (m *mockMediaService) Upload(ctx context.Context, fileName string, r io.Reader) (*model.Media, error) {
+	return m.UploadFunc(ctx, fileName, r)
+}
+func (m *mockMediaService) Delete(ctx context.Context, _ int) error { return nil }
+
+func TestMediaHandler_UploadMedia(t *testing.T) {
+	var gotName string
+	svc := &mockMediaService{
+		UploadFunc: func(ctx context.Context, fileName string, r io.Reader) (*model.Media, error) {
+			gotName = fileName
+			return &model.Media{ID: 1, FileName: fileName, URL: "/uploads/x.png"}, nil
+		},
+	}
+	h := NewMediaHandler(svc)
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, _ := mw.CreateFormFile("file", "logo.png")
+	fw.Write([]byte("png"))
+	mw.Close()
+	r := httptest.NewRequest(http.MethodPost, "/api/media", &body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+
+	h.UploadMedia(w, r)
+	if w.Code != http.StatusCreated || gotName != "logo.png" {
+		t.Errorf("expected 201 for logo.png, got %d for %q", w.Code, gotName)
+	}
+}
+
+func TestMediaHandler_UploadMedia_MissingFile(t *testing.T) {
+	h := NewMediaHandler(&mockMediaService{})
+	r := httptest.NewRequest(http.MethodPost, "/api/media", bytes.NewReader(nil))
+	w := httptest.NewRecorder()
+
+	h.UploadMedia(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
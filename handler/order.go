@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+)
+
+// writeReorder takes {"ids": [3, 1, 2]} and passes the ids to reorder. op
+// and component label the log lines.
+func writeReorder(w http.ResponseWriter, r *http.Request, op, component string, reorder func(context.Context, []int) error) {
+	var body struct {
+		IDs []int `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		slog.WarnContext(r.Context(), op+" decode error", "component", component, "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := reorder(r.Context(), body.IDs); err != nil {
+		slog.ErrorContext(r.Context(), op+" service error", "component", component, "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), op+" success", "component", component, "ids", body.IDs)
+	w.WriteHeader(http.StatusNoContent)
+}
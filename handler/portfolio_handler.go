@@ -1,135 +1,250 @@
 package handler
 
 import (
+	"database/sql"
 	"encoding/json"
-	"html/template"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
-	"path/filepath"
+	"porto/dto"
+	"porto/i18n"
+	"porto/middleware"
 	"porto/model"
+	"porto/seo"
 	"porto/service"
 	"strconv"
 
-	"github.com/gorilla/mux"
+	"github.com/go-chi/chi/v5"
 )
 
 type PortfolioHandler struct {
-	Service     service.PortfolioService
-	TemplateDir string
+	Service            service.PortfolioService
+	ExperienceService  service.ExperienceService
+	SkillService       service.SkillService
+	TestimonialService service.TestimonialService
+	TemplateDir        string
+	// Site feeds the titles, share tags and structured data of the pages.
+	Site           seo.Site
+	ProfileService service.ProfileService
 }
 
-func NewPortfolioHandler(s service.PortfolioService, templateDir string) *PortfolioHandler {
-	return &PortfolioHandler{Service: s, TemplateDir: templateDir}
+func NewPortfolioHandler(s service.PortfolioService, es service.ExperienceService, ss service.SkillService, ts service.TestimonialService, ps service.ProfileService, site seo.Site, templateDir string) *PortfolioHandler {
+	return &PortfolioHandler{Service: s, ExperienceService: es, SkillService: ss, TestimonialService: ts, ProfileService: ps, Site: site, TemplateDir: templateDir}
 }
 
 func (h *PortfolioHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
 	projects, err := h.Service.GetAll(r.Context())
 	if err != nil {
-		log.Printf("[PortfolioHandler] GetProjects error: %v", err)
+		slog.ErrorContext(r.Context(), "GetProjects error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	log.Printf("[PortfolioHandler] GetProjects success, count: %d", len(projects))
-	json.NewEncoder(w).Encode(projects)
+	slog.InfoContext(r.Context(), "GetProjects success", "component", "PortfolioHandler", "count", len(projects))
+	json.NewEncoder(w).Encode(dto.NewProjectsV1(projects))
 }
 
 func (h *PortfolioHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
-	var p model.Portfolio
-	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
-		log.Printf("[PortfolioHandler] CreateProject decode error: %v", err)
+	var d dto.ProjectV1
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "CreateProject decode error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	var p model.Portfolio
+	d.Apply(&p)
 	if err := h.Service.Create(r.Context(), &p); err != nil {
-		log.Printf("[PortfolioHandler] CreateProject service error: %v", err)
+		slog.ErrorContext(r.Context(), "CreateProject service error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(err.Error()))
+		w.Write([]byte(i18n.Error(r.Context(), err)))
 		return
 	}
-	log.Printf("[PortfolioHandler] CreateProject success: %+v", p)
+	slog.InfoContext(r.Context(), "CreateProject success", "component", "PortfolioHandler", "id", p.ID)
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(p)
+	json.NewEncoder(w).Encode(dto.NewProjectV1(p))
 }
 
+// UpdateProject keeps the fields v1 clients do not send, such as the slug
+// and tags, from the stored project.
 func (h *PortfolioHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
-	var p model.Portfolio
-	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
-		log.Printf("[PortfolioHandler] UpdateProject decode error: %v", err)
+	var d dto.ProjectV1
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "UpdateProject decode error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	var p model.Portfolio
+	if d.ID != 0 {
+		existing, err := h.Service.GetByID(r.Context(), d.ID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "UpdateProject lookup error", "component", "PortfolioHandler", "error", err)
+			writeLookupError(w, err)
+			return
+		}
+		p = *existing
+	}
+	d.Apply(&p)
 	if err := h.Service.Update(r.Context(), &p); err != nil {
-		log.Printf("[PortfolioHandler] UpdateProject service error: %v", err)
+		slog.ErrorContext(r.Context(), "UpdateProject service error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
-		w.Write([]byte(err.Error()))
+		w.Write([]byte(i18n.Error(r.Context(), err)))
 		return
 	}
-	log.Printf("[PortfolioHandler] UpdateProject success: %+v", p)
+	slog.InfoContext(r.Context(), "UpdateProject success", "component", "PortfolioHandler", "id", p.ID)
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(p)
+	json.NewEncoder(w).Encode(dto.NewProjectV1(p))
 }
 
 func (h *PortfolioHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
-	idStr := mux.Vars(r)["id"]
+	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		log.Printf("[PortfolioHandler] DeleteProject invalid id: %v", err)
+		slog.WarnContext(r.Context(), "DeleteProject invalid id", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 	if err := h.Service.Delete(r.Context(), id); err != nil {
-		log.Printf("[PortfolioHandler] DeleteProject service error: %v", err)
+		slog.ErrorContext(r.Context(), "DeleteProject service error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	log.Printf("[PortfolioHandler] DeleteProject success, id: %d", id)
+	slog.InfoContext(r.Context(), "DeleteProject success", "component", "PortfolioHandler", "id", id)
 	w.WriteHeader(http.StatusNoContent)
 }
 
+type PortfolioPageData struct {
+	Projects  []model.Portfolio
+	Profile   model.Profile
+	CSRFToken string
+	Meta      seo.Meta
+}
+
 // Render halaman daftar portfolio (HTML dinamis)
 func (h *PortfolioHandler) RenderPortfolioPage(w http.ResponseWriter, r *http.Request) {
 	projects, err := h.Service.GetAll(r.Context())
 	if err != nil {
-		log.Printf("[PortfolioHandler] RenderPortfolioPage error: %v", err)
+		slog.ErrorContext(r.Context(), "RenderPortfolioPage error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	tmplPath := filepath.Join(h.TemplateDir, "portfolio.html")
-	tmpl, err := template.ParseFiles(tmplPath)
+	profile, err := loadProfile(r, h.ProfileService)
 	if err != nil {
-		log.Printf("[PortfolioHandler] template parse error: %v", err)
+		slog.ErrorContext(r.Context(), "RenderPortfolioPage profile error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	err = tmpl.Execute(w, projects)
-	if err != nil {
-		log.Printf("[PortfolioHandler] template execute error: %v", err)
-		w.WriteHeader(http.StatusInternalServerError)
+	locale := i18n.Locale(r.Context())
+	for i := range projects {
+		projects[i] = projects[i].Localized(locale)
 	}
+	data := PortfolioPageData{Projects: projects, Profile: profile, CSRFToken: middleware.CSRFToken(r)}
+	data.Meta = h.Site.WithProfile(profile).In(locale).Page("/portfolio", i18n.T(locale, "portfolio.title"), "")
+	h.render(w, r, "portfolio", data)
 }
 
-type AboutData struct {
-	Name     string
-	Bio      string
-	ImageURL string
+type ProjectPageData struct {
+	Project   model.Portfolio
+	Profile   model.Profile
+	CSRFToken string
+	Meta      seo.Meta
 }
 
-func (h *PortfolioHandler) RenderAboutPage(w http.ResponseWriter, r *http.Request) {
-	data := AboutData{
-		Name:     "Fauzan Alsya Prasetyo",
-		Bio:      "Saya adalah seorang software engineer yang berfokus pada pengembangan aplikasi web dengan arsitektur clean architecture dan Go.",
-		ImageURL: "img/about-us.png",
+// RenderProjectPage shows the project at {slug}.
+func (h *PortfolioHandler) RenderProjectPage(w http.ResponseWriter, r *http.Request) {
+	p, err := h.Service.GetBySlug(r.Context(), chi.URLParam(r, "slug"))
+	if errors.Is(err, sql.ErrNoRows) {
+		http.NotFound(w, r)
+		return
 	}
-	tmplPath := filepath.Join(h.TemplateDir, "about.html")
-	tmpl, err := template.ParseFiles(tmplPath)
 	if err != nil {
+		slog.ErrorContext(r.Context(), "RenderProjectPage error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte("Template error: " + err.Error()))
 		return
 	}
-	err = tmpl.Execute(w, data)
+	profile, err := loadProfile(r, h.ProfileService)
 	if err != nil {
+		slog.ErrorContext(r.Context(), "RenderProjectPage profile error", "component", "PortfolioHandler", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte("Render error: " + err.Error()))
+		return
+	}
+	locale := i18n.Locale(r.Context())
+	project := p.Localized(locale)
+	h.render(w, r, "project", ProjectPageData{Project: project, Profile: profile, CSRFToken: middleware.CSRFToken(r), Meta: h.Site.WithProfile(profile).In(locale).Project(project)})
+}
+
+func (h *PortfolioHandler) render(w http.ResponseWriter, r *http.Request, page string, data any) {
+	tmpl, err := parsePage(h.TemplateDir, page, i18n.Locale(r.Context()))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "template parse error", "component", "PortfolioHandler", "page", page, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err := tmpl.Execute(w, data); err != nil {
+		slog.ErrorContext(r.Context(), "template execute error", "component", "PortfolioHandler", "page", page, "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+type AboutData struct {
+	Profile      model.Profile
+	Experiences  []model.Experience
+	Skills       []model.SkillUsage
+	Testimonials []model.Testimonial
+	CSRFToken    string
+	Meta         seo.Meta
+}
+
+func (h *PortfolioHandler) RenderAboutPage(w http.ResponseWriter, r *http.Request) {
+	var data AboutData
+	var err error
+	if data.Profile, err = loadProfile(r, h.ProfileService); err != nil {
+		slog.ErrorContext(r.Context(), "RenderAboutPage profile error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	locale := i18n.Locale(r.Context())
+	if h.ExperienceService != nil {
+		exps, err := h.ExperienceService.GetAll(r.Context())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "RenderAboutPage experiences error", "component", "PortfolioHandler", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		for i := range exps {
+			exps[i] = exps[i].Localized(locale)
+		}
+		data.Experiences = exps
+	}
+	if h.SkillService != nil {
+		skills, err := h.SkillService.GetUsage(r.Context(), "")
+		if err != nil {
+			slog.ErrorContext(r.Context(), "RenderAboutPage skills error", "component", "PortfolioHandler", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data.Skills = skills
+	}
+	if h.TestimonialService != nil {
+		testimonials, err := h.TestimonialService.GetApproved(r.Context())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "RenderAboutPage testimonials error", "component", "PortfolioHandler", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data.Testimonials = testimonials
+	}
+	data.CSRFToken = middleware.CSRFToken(r)
+	site := h.Site.WithProfile(data.Profile).In(locale)
+	data.Meta = site.Page("/about", i18n.T(locale, "nav.about"), data.Profile.Bio)
+	data.Meta.Type = "profile"
+	data.Meta.JSONLD = []any{site.PersonLD()}
+	h.render(w, r, "about", data)
+}
+
+// writeLookupError maps an unknown id or slug to 404 and anything else to 500.
+func writeLookupError(w http.ResponseWriter, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		w.WriteHeader(http.StatusNotFound)
+		return
 	}
+	w.WriteHeader(http.StatusInternalServerError)
 }
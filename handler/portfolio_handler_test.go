@@ -3,31 +3,52 @@ package handler
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"porto/i18n"
 	"porto/model"
+	"porto/seo"
+
+	"github.com/go-chi/chi/v5"
 )
 
 type mockPortfolioService struct {
-	GetAllFunc func(ctx context.Context) ([]model.Portfolio, error)
-	CreateFunc func(ctx context.Context, p *model.Portfolio) error
+	GetAllFunc    func(ctx context.Context) ([]model.Portfolio, error)
+	GetBySlugFunc func(ctx context.Context, slug string) (*model.Portfolio, error)
+	CreateFunc    func(ctx context.Context, p *model.Portfolio) error
+	UpdateFunc    func(ctx context.Context, p *model.Portfolio) error
+	stored        *model.Portfolio
 }
 
 func (m *mockPortfolioService) GetAll(ctx context.Context) ([]model.Portfolio, error) {
 	return m.GetAllFunc(ctx)
 }
 func (m *mockPortfolioService) GetByID(ctx context.Context, id int) (*model.Portfolio, error) {
-	return nil, nil
+	if m.stored != nil && m.stored.ID == id {
+		p := *m.stored
+		return &p, nil
+	}
+	return nil, sql.ErrNoRows
+}
+func (m *mockPortfolioService) GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error) {
+	return m.GetBySlugFunc(ctx, slug)
 }
 func (m *mockPortfolioService) Create(ctx context.Context, p *model.Portfolio) error {
 	return m.CreateFunc(ctx, p)
 }
-func (m *mockPortfolioService) Update(ctx context.Context, _ *model.Portfolio) error { return nil }
-func (m *mockPortfolioService) Delete(ctx context.Context, _ int) error              { return nil }
+func (m *mockPortfolioService) Update(ctx context.Context, p *model.Portfolio) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, p)
+	}
+	return nil
+}
+func (m *mockPortfolioService) Delete(ctx context.Context, _ int) error { return nil }
 
 func TestPortfolioHandler_GetProjects(t *testing.T) {
 	svc := &mockPortfolioService{
@@ -35,7 +56,7 @@ func TestPortfolioHandler_GetProjects(t *testing.T) {
 			return []model.Portfolio{{ID: 1, Name: "A"}}, nil
 		},
 	}
-	h := NewPortfolioHandler(svc, "")
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
 	r := httptest.NewRequest(http.MethodGet, "/api/projects", nil)
 	w := httptest.NewRecorder()
 
@@ -54,7 +75,7 @@ func TestPortfolioHandler_CreateProject(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewPortfolioHandler(svc, "")
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
 	body, _ := json.Marshal(model.Portfolio{Name: "A", Description: "B"})
 	r := httptest.NewRequest(http.MethodPost, "/api/projects", bytes.NewReader(body))
 	w := httptest.NewRecorder()
@@ -71,7 +92,7 @@ func TestPortfolioHandler_GetProjects_Error(t *testing.T) {
 			return nil, errors.New("db error")
 		},
 	}
-	h := NewPortfolioHandler(svc, "")
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
 	r := httptest.NewRequest(http.MethodGet, "/api/projects", nil)
 	w := httptest.NewRecorder()
 
@@ -87,7 +108,7 @@ func TestPortfolioHandler_CreateProject_BadRequest(t *testing.T) {
 			return nil
 		},
 	}
-	h := NewPortfolioHandler(svc, "")
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
 	r := httptest.NewRequest(http.MethodPost, "/api/projects", bytes.NewReader([]byte("notjson")))
 	w := httptest.NewRecorder()
 
@@ -103,7 +124,7 @@ func TestPortfolioHandler_CreateProject_ServiceError(t *testing.T) {
 			return errors.New("service error")
 		},
 	}
-	h := NewPortfolioHandler(svc, "")
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
 	body, _ := json.Marshal(model.Portfolio{Name: "A", Description: "B"})
 	r := httptest.NewRequest(http.MethodPost, "/api/projects", bytes.NewReader(body))
 	w := httptest.NewRecorder()
@@ -113,3 +134,118 @@ func TestPortfolioHandler_CreateProject_ServiceError(t *testing.T) {
 		t.Errorf("expected 400, got %d", w.Code)
 	}
 }
+
+func TestPortfolioHandler_UpdateProject_KeepsV2Fields(t *testing.T) {
+	var got model.Portfolio
+	svc := &mockPortfolioService{
+		stored: &model.Portfolio{ID: 1, Name: "Old", Slug: "old", Tags: []string{"go"}},
+		UpdateFunc: func(ctx context.Context, p *model.Portfolio) error {
+			got = *p
+			return nil
+		},
+	}
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
+
+	w := httptest.NewRecorder()
+	h.UpdateProject(w, httptest.NewRequest(http.MethodPut, "/api/v1/projects", strings.NewReader(`{"id":1,"name":"New","description":"B"}`)))
+	if w.Code != http.StatusOK || got.Name != "New" || got.Slug != "old" || len(got.Tags) != 1 {
+		t.Errorf("expected slug and tags to be kept, got %d %+v", w.Code, got)
+	}
+	if strings.Contains(w.Body.String(), "slug") {
+		t.Errorf("expected the v1 response without v2 fields, got %s", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	h.UpdateProject(w, httptest.NewRequest(http.MethodPut, "/api/v1/projects", strings.NewReader(`{"id":2,"name":"New","description":"B"}`)))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown id, got %d", w.Code)
+	}
+}
+
+func TestPortfolioHandler_GetProjectV2(t *testing.T) {
+	svc := &mockPortfolioService{
+		GetBySlugFunc: func(ctx context.Context, slug string) (*model.Portfolio, error) {
+			if slug != "company-site" {
+				return nil, sql.ErrNoRows
+			}
+			return &model.Portfolio{ID: 1, Name: "Company site", Slug: slug, Tags: []string{"go"}}, nil
+		},
+	}
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "")
+	router := chi.NewRouter()
+	router.Get("/api/v2/projects/{slug}", h.GetProjectV2)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/projects/company-site", nil))
+	var body map[string]any
+	json.NewDecoder(w.Body).Decode(&body)
+	if w.Code != http.StatusOK || body["slug"] != "company-site" || body["tags"] == nil {
+		t.Errorf("expected the v2 project, got %d %v", w.Code, body)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/projects/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestPortfolioHandler_RenderProjectPage(t *testing.T) {
+	svc := &mockPortfolioService{
+		GetBySlugFunc: func(ctx context.Context, slug string) (*model.Portfolio, error) {
+			if slug != "company-site" {
+				return nil, sql.ErrNoRows
+			}
+			return &model.Portfolio{ID: 1, Name: "Company site", Slug: slug, Description: "A site for a company"}, nil
+		},
+	}
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{Name: "Ann", BaseURL: "https://example.com"}, "../WebView")
+	router := chi.NewRouter()
+	router.Get("/portfolio/{slug}", h.RenderProjectPage)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/portfolio/company-site", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, want := range []string{
+		"<title>Company site | Ann</title>",
+		`<link rel="canonical" href="https://example.com/en/portfolio/company-site">`,
+		`<link rel="alternate" hreflang="id" href="https://example.com/id/portfolio/company-site">`,
+		`<link rel="alternate" hreflang="x-default" href="https://example.com/portfolio/company-site">`,
+		`"@type":"CreativeWork"`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in the page", want)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/portfolio/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestPortfolioHandler_RenderProjectPage_Localized(t *testing.T) {
+	svc := &mockPortfolioService{
+		GetBySlugFunc: func(ctx context.Context, slug string) (*model.Portfolio, error) {
+			return &model.Portfolio{ID: 1, Name: "Company site", Slug: slug, Description: "A site", Link: "https://example.com",
+				Translations: model.Translations[model.PortfolioText]{"id": {Name: "Situs perusahaan"}}}, nil
+		},
+	}
+	h := NewPortfolioHandler(svc, nil, nil, nil, noProfile, seo.Site{}, "../WebView")
+	router := chi.NewRouter()
+	router.Get("/portfolio/{slug}", h.RenderProjectPage)
+
+	r := httptest.NewRequest(http.MethodGet, "/portfolio/company-site", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r.WithContext(i18n.WithLocale(r.Context(), "id")))
+	body := w.Body.String()
+	for _, want := range []string{`<html lang="id">`, "<h2>Situs perusahaan</h2>", "A site", "<span>Situs web</span>"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in the page", want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/dto"
+	"porto/i18n"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// The v2 project endpoints address projects by slug and include tags and
+// timestamps.
+
+func (h *PortfolioHandler) GetProjectsV2(w http.ResponseWriter, r *http.Request) {
+	projects, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetProjectsV2 error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(dto.NewProjectsV2(projects))
+}
+
+func (h *PortfolioHandler) GetProjectV2(w http.ResponseWriter, r *http.Request) {
+	p, err := h.Service.GetBySlug(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetProjectV2 error", "component", "PortfolioHandler", "error", err)
+		writeLookupError(w, err)
+		return
+	}
+	json.NewEncoder(w).Encode(dto.NewProjectV2(*p))
+}
+
+func (h *PortfolioHandler) CreateProjectV2(w http.ResponseWriter, r *http.Request) {
+	var d dto.ProjectV2
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "CreateProjectV2 decode error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	p := d.Model()
+	if err := h.Service.Create(r.Context(), &p); err != nil {
+		slog.ErrorContext(r.Context(), "CreateProjectV2 service error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "CreateProjectV2 success", "component", "PortfolioHandler", "id", p.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dto.NewProjectV2(p))
+}
+
+// UpdateProjectV2 replaces the project at {slug}; the body may carry a new
+// slug to rename it.
+func (h *PortfolioHandler) UpdateProjectV2(w http.ResponseWriter, r *http.Request) {
+	existing, err := h.Service.GetBySlug(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "UpdateProjectV2 lookup error", "component", "PortfolioHandler", "error", err)
+		writeLookupError(w, err)
+		return
+	}
+	var d dto.ProjectV2
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "UpdateProjectV2 decode error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	p := d.Model()
+	p.ID = existing.ID
+	if p.Slug == "" {
+		p.Slug = existing.Slug
+	}
+	if p.Translations == nil {
+		p.Translations = existing.Translations
+	}
+	if err := h.Service.Update(r.Context(), &p); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateProjectV2 service error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateProjectV2 success", "component", "PortfolioHandler", "id", p.ID)
+	json.NewEncoder(w).Encode(dto.NewProjectV2(p))
+}
+
+func (h *PortfolioHandler) DeleteProjectV2(w http.ResponseWriter, r *http.Request) {
+	p, err := h.Service.GetBySlug(r.Context(), chi.URLParam(r, "slug"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "DeleteProjectV2 lookup error", "component", "PortfolioHandler", "error", err)
+		writeLookupError(w, err)
+		return
+	}
+	if err := h.Service.Delete(r.Context(), p.ID); err != nil {
+		slog.ErrorContext(r.Context(), "DeleteProjectV2 service error", "component", "PortfolioHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "DeleteProjectV2 success", "component", "PortfolioHandler", "id", p.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+	"porto/model"
+	"porto/service"
+)
+
+type ProfileHandler struct {
+	Service service.ProfileService
+}
+
+func NewProfileHandler(s service.ProfileService) *ProfileHandler {
+	return &ProfileHandler{Service: s}
+}
+
+func (h *ProfileHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	p, err := h.Service.Get(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetProfile error", "component", "ProfileHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(p)
+}
+
+// UpdateProfile replaces the profile. Omitted translations are kept.
+func (h *ProfileHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	var p model.Profile
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		slog.WarnContext(r.Context(), "UpdateProfile decode error", "component", "ProfileHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Update(r.Context(), &p); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateProfile service error", "component", "ProfileHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateProfile success", "component", "ProfileHandler")
+	json.NewEncoder(w).Encode(p)
+}
+
+// loadProfile returns the profile every page shows, in the request's
+// locale.
+func loadProfile(r *http.Request, s service.ProfileService) (model.Profile, error) {
+	p, err := s.Get(r.Context())
+	if err != nil {
+		return model.Profile{}, err
+	}
+	return p.Localized(i18n.Locale(r.Context())), nil
+}
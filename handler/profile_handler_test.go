@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"porto/i18n"
+	"porto/model"
+	"porto/seo"
+)
+
+type mockProfileService struct {
+	GetFunc    func(ctx context.Context) (*model.Profile, error)
+	UpdateFunc func(ctx context.Context, p *model.Profile) error
+}
+
+func (m *mockProfileService) Get(ctx context.Context) (*model.Profile, error) {
+	return m.GetFunc(ctx)
+}
+func (m *mockProfileService) Update(ctx context.Context, p *model.Profile) error {
+	return m.UpdateFunc(ctx, p)
+}
+
+// noProfile serves the empty profile of a site that has not filled it in.
+var noProfile = &mockProfileService{GetFunc: func(ctx context.Context) (*model.Profile, error) {
+	return &model.Profile{}, nil
+}}
+
+func testProfile(ctx context.Context) (*model.Profile, error) {
+	return &model.Profile{
+		Name:         "Jane Doe",
+		Headline:     "Go developer",
+		Bio:          "Builds web backends.",
+		Phone:        "+62 812 0000",
+		Email:        "jane@example.test",
+		Location:     "Jakarta",
+		Availability: model.AvailabilityBusy,
+		Links:        model.SocialLinks{{Network: "github", URL: "https://github.com/jane"}},
+		Translations: model.Translations[model.ProfileText]{"id": {Bio: "Membangun backend web."}},
+	}, nil
+}
+
+func TestProfileHandler_GetProfile(t *testing.T) {
+	h := NewProfileHandler(&mockProfileService{GetFunc: testProfile})
+	w := httptest.NewRecorder()
+	h.GetProfile(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+
+	var got model.Profile
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil || got.Name != "Jane Doe" || len(got.Links) != 1 {
+		t.Errorf("expected the profile, got %+v, err %v", got, err)
+	}
+
+	h = NewProfileHandler(&mockProfileService{GetFunc: func(ctx context.Context) (*model.Profile, error) {
+		return nil, errors.New("db error")
+	}})
+	w = httptest.NewRecorder()
+	h.GetProfile(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
+
+func TestProfileHandler_UpdateProfile(t *testing.T) {
+	h := NewProfileHandler(&mockProfileService{UpdateFunc: func(ctx context.Context, p *model.Profile) error {
+		if p.Name == "" {
+			return errors.New("profile name is required")
+		}
+		return nil
+	}})
+
+	body, _ := json.Marshal(model.Profile{Name: "Jane Doe"})
+	w := httptest.NewRecorder()
+	h.UpdateProfile(w, httptest.NewRequest(http.MethodPut, "/api/v1/profile", bytes.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	h.UpdateProfile(w, httptest.NewRequest(http.MethodPut, "/api/v1/profile", strings.NewReader(`{"name": ""}`)))
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "profile name is required") {
+		t.Errorf("expected 400 with the validation error, got %d %q", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	h.UpdateProfile(w, httptest.NewRequest(http.MethodPut, "/api/v1/profile", strings.NewReader("{")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestContactHandler_RenderContactPage_Profile(t *testing.T) {
+	h := NewContactHandler(&mockContactService{}, &mockProfileService{GetFunc: testProfile}, seo.Site{}, "../WebView")
+	w := httptest.NewRecorder()
+	h.RenderContactPage(w, httptest.NewRequest(http.MethodGet, "/contact", nil))
+
+	body := w.Body.String()
+	for _, want := range []string{`href="mailto:jane@example.test"`, "812 0000", "Jakarta", `href="https://github.com/jane"`, "fa fa-github"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in the page", want)
+		}
+	}
+}
+
+func TestHomeHandler_RenderHomePage_LocalizedProfile(t *testing.T) {
+	h := newTestHomeHandler(func(ctx context.Context) ([]model.Client, error) { return nil, nil })
+	h.ProfileService = &mockProfileService{GetFunc: testProfile}
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	h.RenderHomePage(w, r.WithContext(i18n.WithLocale(r.Context(), "id")))
+
+	body := w.Body.String()
+	for _, want := range []string{"I am Jane Doe", "Go developer", `"name":"Jane Doe"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in the page", want)
+		}
+	}
+}
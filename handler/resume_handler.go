@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"porto/cv"
+	"porto/dto"
+	"porto/i18n"
+	"porto/model"
+	"porto/service"
+	"slices"
+	"strings"
+)
+
+// ResumeHandler exports and imports the site data as a JSON Resume and
+// renders it as a PDF CV.
+type ResumeHandler struct {
+	Service           service.ResumeService
+	PortfolioService  service.PortfolioService
+	ExperienceService service.ExperienceService
+	SkillService      service.SkillService
+	ProfileService    service.ProfileService
+	// Basics holds the owner fields the profile lacks, such as the site URL.
+	Basics dto.ResumeBasics
+	cv     *cv.Cache
+}
+
+func NewResumeHandler(s service.ResumeService, ps service.PortfolioService, es service.ExperienceService, ss service.SkillService, prs service.ProfileService, basics dto.ResumeBasics) *ResumeHandler {
+	return &ResumeHandler{Service: s, PortfolioService: ps, ExperienceService: es, SkillService: ss, ProfileService: prs, Basics: basics, cv: cv.NewCache()}
+}
+
+func (h *ResumeHandler) GetResume(w http.ResponseWriter, r *http.Request) {
+	basics, exps, projects, skills, err := h.data(r)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetResume error", "component", "ResumeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(dto.NewResume(basics, exps, projects, skills))
+}
+
+// GetCV serves the résumé as a PDF in the ?theme= theme. ?projects= picks
+// projects by slug, e.g. "shop,blog"; by default the most recent ones are
+// shown. The ETag changes whenever the underlying data does.
+func (h *ResumeHandler) GetCV(w http.ResponseWriter, r *http.Request) {
+	theme := r.URL.Query().Get("theme")
+	if theme == "" {
+		theme = cv.DefaultTheme
+	}
+	if _, ok := cv.Themes[theme]; !ok {
+		slog.WarnContext(r.Context(), "GetCV unknown theme", "component", "ResumeHandler", "theme", theme)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("unknown theme: " + theme))
+		return
+	}
+	basics, exps, projects, skills, err := h.data(r)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetCV error", "component", "ResumeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	projects = selectProjects(projects, r.URL.Query().Get("projects"))
+	pdf, etag, err := h.cv.Get(dto.NewResume(basics, exps, projects, skills), theme)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetCV render error", "component", "ResumeHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="cv.pdf"`)
+	w.Write(pdf)
+}
+
+// data loads everything a résumé is built from.
+func (h *ResumeHandler) data(r *http.Request) (dto.ResumeBasics, []model.Experience, []model.Portfolio, []model.Skill, error) {
+	ctx := r.Context()
+	profile, err := loadProfile(r, h.ProfileService)
+	if err != nil {
+		return dto.ResumeBasics{}, nil, nil, nil, fmt.Errorf("profile: %w", err)
+	}
+	exps, err := h.ExperienceService.GetAll(ctx)
+	if err != nil {
+		return dto.ResumeBasics{}, nil, nil, nil, fmt.Errorf("experiences: %w", err)
+	}
+	projects, err := h.PortfolioService.GetAll(ctx)
+	if err != nil {
+		return dto.ResumeBasics{}, nil, nil, nil, fmt.Errorf("projects: %w", err)
+	}
+	skills, err := h.SkillService.GetAll(ctx)
+	if err != nil {
+		return dto.ResumeBasics{}, nil, nil, nil, fmt.Errorf("skills: %w", err)
+	}
+	return h.Basics.WithProfile(profile), exps, projects, skills, nil
+}
+
+// cvProjects is how many recent projects the CV shows by default.
+const cvProjects = 4
+
+// selectProjects returns the projects with the comma-separated slugs in
+// that order, or the most recent ones when slugs is empty.
+func selectProjects(projects []model.Portfolio, slugs string) []model.Portfolio {
+	if slugs == "" {
+		projects = slices.Clone(projects)
+		slices.SortStableFunc(projects, func(a, b model.Portfolio) int { return b.CreatedAt.Compare(a.CreatedAt) })
+		return projects[:min(len(projects), cvProjects)]
+	}
+	var selected []model.Portfolio
+	for _, slug := range strings.Split(slugs, ",") {
+		i := slices.IndexFunc(projects, func(p model.Portfolio) bool { return p.Slug == strings.TrimSpace(slug) })
+		if i >= 0 {
+			selected = append(selected, projects[i])
+		}
+	}
+	return selected
+}
+
+// ImportResume creates or updates experiences and projects from the work
+// and projects sections of a JSON Resume; the other sections are ignored.
+func (h *ResumeHandler) ImportResume(w http.ResponseWriter, r *http.Request) {
+	var d dto.Resume
+	if err := json.NewDecoder(r.Body).Decode(&d); err != nil {
+		slog.WarnContext(r.Context(), "ImportResume decode error", "component", "ResumeHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	exps, err := d.Experiences()
+	if err != nil {
+		slog.WarnContext(r.Context(), "ImportResume date error", "component", "ResumeHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	result, err := h.Service.Import(r.Context(), exps, d.Portfolios())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "ImportResume service error", "component", "ResumeHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "ImportResume success", "component", "ResumeHandler")
+	json.NewEncoder(w).Encode(result)
+}
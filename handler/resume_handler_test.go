@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"porto/dto"
+	"porto/model"
+)
+
+type mockResumeService struct {
+	ImportFunc func(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error)
+}
+
+func (m *mockResumeService) Import(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error) {
+	return m.ImportFunc(ctx, exps, projects)
+}
+
+func TestResumeHandler_GetResume(t *testing.T) {
+	h := NewResumeHandler(nil,
+		&mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) {
+			return []model.Portfolio{{ID: 1, Name: "Shop", Description: "d", Link: "https://shop.example", Tags: []string{"go"}}}, nil
+		}},
+		&mockExperienceService{GetAllFunc: func(ctx context.Context) ([]model.Experience, error) {
+			return []model.Experience{
+				{Title: "Engineer", Company: "Acme", StartDate: model.NewDate(2022, time.March, 1), IsCurrent: true},
+				{Title: "Intern", Company: "Initech", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.June, 30)},
+			}, nil
+		}},
+		&mockSkillService{},
+		&mockProfileService{GetFunc: testProfile},
+		dto.ResumeBasics{URL: "https://example.com"},
+	)
+	w := httptest.NewRecorder()
+	h.GetResume(w, httptest.NewRequest(http.MethodGet, "/api/resume.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	var got dto.Resume
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if b := got.Basics; b.Name != "Jane Doe" || b.Label != "Go developer" || b.Email != "jane@example.test" || b.URL != "https://example.com" {
+		t.Errorf("expected the basics from the profile, got %+v", b)
+	}
+	if len(got.Work) != 2 || len(got.Projects) != 1 {
+		t.Fatalf("unexpected resume: %+v", got)
+	}
+	if w := got.Work[0]; w.Name != "Acme" || w.Position != "Engineer" || w.StartDate != "2022-03-01" || w.EndDate != "" {
+		t.Errorf("unexpected current position: %+v", w)
+	}
+	if got.Work[1].EndDate != "2021-06-30" {
+		t.Errorf("expected the end date, got %+v", got.Work[1])
+	}
+	if p := got.Projects[0]; p.URL != "https://shop.example" || len(p.Keywords) != 1 {
+		t.Errorf("unexpected project: %+v", p)
+	}
+}
+
+func TestResumeHandler_ImportResume(t *testing.T) {
+	var gotExps []model.Experience
+	var gotProjects []model.Portfolio
+	h := NewResumeHandler(&mockResumeService{
+		ImportFunc: func(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error) {
+			gotExps, gotProjects = exps, projects
+			return &model.ResumeImport{ExperiencesCreated: len(exps), ProjectsCreated: len(projects)}, nil
+		},
+	}, nil, nil, nil, noProfile, dto.ResumeBasics{})
+
+	body := `{"basics":{"name":"Ann"},"work":[{"name":"Acme","position":"Engineer","startDate":"2022-03"}],
+		"projects":[{"name":"Shop","description":"d","url":"https://shop.example","keywords":["Go"]}]}`
+	w := httptest.NewRecorder()
+	h.ImportResume(w, httptest.NewRequest(http.MethodPost, "/api/resume/import", strings.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(gotExps) != 1 || !gotExps[0].IsCurrent || gotExps[0].StartDate != model.NewDate(2022, time.March, 1) {
+		t.Errorf("unexpected experiences: %+v", gotExps)
+	}
+	if len(gotProjects) != 1 || gotProjects[0].Link != "https://shop.example" {
+		t.Errorf("unexpected projects: %+v", gotProjects)
+	}
+
+	// invalid date
+	w = httptest.NewRecorder()
+	body = `{"work":[{"name":"Acme","position":"Engineer","startDate":"March 2022"}]}`
+	h.ImportResume(w, httptest.NewRequest(http.MethodPost, "/api/resume/import", strings.NewReader(body)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+// TestResumeHandler_RoundTrip imports the site's own export, which must
+// match every entry to the stored one instead of creating copies.
+func TestResumeHandler_RoundTrip(t *testing.T) {
+	stored := []model.Portfolio{
+		{ID: 7, Slug: "shop-7", Name: "Shop", Description: "d"},
+		{ID: 8, Slug: "blog", Name: "My blog", Description: "d"},
+	}
+	exps := []model.Experience{{ID: 1, Title: "Engineer", Company: "Acme", StartDate: model.NewDate(2022, time.March, 1), IsCurrent: true}}
+	h := NewResumeHandler(&mockResumeService{
+		// matches like the repository: projects by slug, positions by
+		// company, title and start date
+		ImportFunc: func(ctx context.Context, gotExps []model.Experience, gotProjects []model.Portfolio) (*model.ResumeImport, error) {
+			var result model.ResumeImport
+			for _, p := range gotProjects {
+				if slices.ContainsFunc(stored, func(s model.Portfolio) bool { return s.Slug == p.Slug }) {
+					result.ProjectsUpdated++
+				} else {
+					result.ProjectsCreated++
+				}
+			}
+			for _, e := range gotExps {
+				if slices.ContainsFunc(exps, func(s model.Experience) bool {
+					return s.Company == e.Company && s.Title == e.Title && s.StartDate == e.StartDate
+				}) {
+					result.ExperiencesUpdated++
+				} else {
+					result.ExperiencesCreated++
+				}
+			}
+			return &result, nil
+		},
+	},
+		&mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) { return stored, nil }},
+		&mockExperienceService{GetAllFunc: func(ctx context.Context) ([]model.Experience, error) { return exps, nil }},
+		&mockSkillService{},
+		noProfile,
+		dto.ResumeBasics{},
+	)
+
+	w := httptest.NewRecorder()
+	h.GetResume(w, httptest.NewRequest(http.MethodGet, "/api/resume.json", nil))
+	r := httptest.NewRequest(http.MethodPost, "/api/resume/import", w.Body)
+	w = httptest.NewRecorder()
+	h.ImportResume(w, r)
+
+	var got model.ResumeImport
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got != (model.ResumeImport{ExperiencesUpdated: 1, ProjectsUpdated: 2}) {
+		t.Errorf("expected only updates, got %+v", got)
+	}
+}
+
+func TestResumeHandler_GetCV(t *testing.T) {
+	h := NewResumeHandler(nil,
+		&mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) {
+			return []model.Portfolio{{ID: 1, Slug: "shop", Name: "Shop", Description: "d"}}, nil
+		}},
+		&mockExperienceService{GetAllFunc: func(ctx context.Context) ([]model.Experience, error) {
+			return []model.Experience{{Title: "Engineer", Company: "Acme", StartDate: model.NewDate(2022, time.March, 1), IsCurrent: true}}, nil
+		}},
+		&mockSkillService{},
+		&mockProfileService{GetFunc: testProfile},
+		dto.ResumeBasics{},
+	)
+
+	w := httptest.NewRecorder()
+	h.GetCV(w, httptest.NewRequest(http.MethodGet, "/cv.pdf?theme=classic", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/pdf" || !strings.HasPrefix(w.Body.String(), "%PDF-") {
+		t.Fatalf("expected a PDF, got %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	etag := w.Header().Get("ETag")
+
+	// unchanged data
+	r := httptest.NewRequest(http.MethodGet, "/cv.pdf?theme=classic", nil)
+	r.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	h.GetCV(w, r)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("expected 304, got %d", w.Code)
+	}
+
+	// unknown theme
+	w = httptest.NewRecorder()
+	h.GetCV(w, httptest.NewRequest(http.MethodGet, "/cv.pdf?theme=neon", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestSelectProjects(t *testing.T) {
+	projects := []model.Portfolio{
+		{Slug: "a", CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Slug: "b", CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if got := selectProjects(projects, ""); got[0].Slug != "b" {
+		t.Errorf("expected the newest project first, got %+v", got)
+	}
+	if got := selectProjects(projects, "a, missing"); len(got) != 1 || got[0].Slug != "a" {
+		t.Errorf("expected only project a, got %+v", got)
+	}
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"porto/seo"
+	"porto/service"
+)
+
+// SEOHandler serves the sitemap and robots.txt.
+type SEOHandler struct {
+	Service service.PortfolioService
+	Site    seo.Site
+	Robots  seo.Robots
+}
+
+func NewSEOHandler(s service.PortfolioService, site seo.Site, robots seo.Robots) *SEOHandler {
+	return &SEOHandler{Service: s, Site: site, Robots: robots}
+}
+
+func (h *SEOHandler) GetSitemap(w http.ResponseWriter, r *http.Request) {
+	projects, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetSitemap error", "component", "SEOHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	body, err := seo.Sitemap(h.Site, projects)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetSitemap render error", "component", "SEOHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Write(body)
+}
+
+func (h *SEOHandler) GetRobots(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(seo.RobotsTxt(h.Site, h.Robots))
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"porto/model"
+	"porto/seo"
+)
+
+func TestSEOHandler_GetSitemap(t *testing.T) {
+	h := NewSEOHandler(&mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) {
+		return []model.Portfolio{{ID: 1, Slug: "shop", Name: "Shop"}}, nil
+	}}, seo.Site{BaseURL: "https://example.com"}, seo.Robots{})
+	w := httptest.NewRecorder()
+	h.GetSitemap(w, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "<loc>https://example.com/id/portfolio/shop</loc>") {
+		t.Errorf("expected the sitemap, got %d %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/xml") {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+}
+
+func TestSEOHandler_GetSitemap_Error(t *testing.T) {
+	h := NewSEOHandler(&mockPortfolioService{GetAllFunc: func(ctx context.Context) ([]model.Portfolio, error) {
+		return nil, errors.New("db error")
+	}}, seo.Site{}, seo.Robots{})
+	w := httptest.NewRecorder()
+	h.GetSitemap(w, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
+
+func TestSEOHandler_GetRobots(t *testing.T) {
+	h := NewSEOHandler(nil, seo.Site{BaseURL: "https://example.com"}, seo.Robots{Disallow: []string{"/api/"}})
+	w := httptest.NewRecorder()
+	h.GetRobots(w, httptest.NewRequest(http.MethodGet, "/robots.txt", nil))
+
+	body := w.Body.String()
+	if !strings.Contains(body, "Disallow: /api/\n") || !strings.Contains(body, "Sitemap: https://example.com/sitemap.xml") {
+		t.Errorf("unexpected robots.txt:\n%s", body)
+	}
+}
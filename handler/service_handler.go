@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+	"porto/middleware"
+	"porto/model"
+	"porto/seo"
+	"porto/service"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ServiceHandler struct {
+	Service            service.ServiceService
+	TestimonialService service.TestimonialService
+	TemplateDir        string
+	// Site feeds the title and share tags of the services page.
+	Site           seo.Site
+	ProfileService service.ProfileService
+}
+
+func NewServiceHandler(s service.ServiceService, ts service.TestimonialService, ps service.ProfileService, site seo.Site, templateDir string) *ServiceHandler {
+	return &ServiceHandler{Service: s, TestimonialService: ts, ProfileService: ps, Site: site, TemplateDir: templateDir}
+}
+
+func (h *ServiceHandler) GetServices(w http.ResponseWriter, r *http.Request) {
+	services, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetServices error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "GetServices success", "component", "ServiceHandler", "count", len(services))
+	json.NewEncoder(w).Encode(services)
+}
+
+func (h *ServiceHandler) CreateService(w http.ResponseWriter, r *http.Request) {
+	var s model.Service
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		slog.WarnContext(r.Context(), "CreateService decode error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Create(r.Context(), &s); err != nil {
+		slog.ErrorContext(r.Context(), "CreateService service error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "CreateService success", "component", "ServiceHandler", "id", s.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(s)
+}
+
+func (h *ServiceHandler) UpdateService(w http.ResponseWriter, r *http.Request) {
+	var s model.Service
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		slog.WarnContext(r.Context(), "UpdateService decode error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Update(r.Context(), &s); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateService service error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateService success", "component", "ServiceHandler", "id", s.ID)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(s)
+}
+
+// ReorderServices takes {"ids": [3, 1, 2]} and displays services in that order.
+func (h *ServiceHandler) ReorderServices(w http.ResponseWriter, r *http.Request) {
+	writeReorder(w, r, "ReorderServices", "ServiceHandler", h.Service.Reorder)
+}
+
+func (h *ServiceHandler) DeleteService(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "DeleteService invalid id", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Delete(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "DeleteService service error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "DeleteService success", "component", "ServiceHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type ServicesPageData struct {
+	Services     []model.Service
+	Testimonials []model.Testimonial
+	Profile      model.Profile
+	CSRFToken    string
+	Meta         seo.Meta
+}
+
+// Render halaman services dari database
+func (h *ServiceHandler) RenderServicesPage(w http.ResponseWriter, r *http.Request) {
+	services, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "RenderServicesPage error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	titles := make([]string, len(services))
+	for i, svc := range services {
+		titles[i] = svc.Title
+	}
+	data := ServicesPageData{Services: services, CSRFToken: middleware.CSRFToken(r)}
+	if data.Profile, err = loadProfile(r, h.ProfileService); err != nil {
+		slog.ErrorContext(r.Context(), "RenderServicesPage profile error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	locale := i18n.Locale(r.Context())
+	data.Meta = h.Site.WithProfile(data.Profile).In(locale).Page("/services", i18n.T(locale, "services.title"), strings.Join(titles, ", "))
+	if h.TestimonialService != nil {
+		data.Testimonials, err = h.TestimonialService.GetApproved(r.Context())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "RenderServicesPage testimonials error", "component", "ServiceHandler", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+	tmpl, err := parsePage(h.TemplateDir, "services", locale)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "template parse error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	err = tmpl.Execute(w, data)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "template execute error", "component", "ServiceHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"porto/model"
+	"porto/seo"
+)
+
+type mockServiceService struct {
+	GetAllFunc  func(ctx context.Context) ([]model.Service, error)
+	ReorderFunc func(ctx context.Context, ids []int) error
+}
+
+func (m *mockServiceService) GetAll(ctx context.Context) ([]model.Service, error) {
+	return m.GetAllFunc(ctx)
+}
+func (m *mockServiceService) GetByID(ctx context.Context, id int) (*model.Service, error) {
+	return nil, nil
+}
+func (m *mockServiceService) Create(ctx context.Context, _ *model.Service) error { return nil }
+func (m *mockServiceService) Update(ctx context.Context, _ *model.Service) error { return nil }
+func (m *mockServiceService) Delete(ctx context.Context, _ int) error            { return nil }
+func (m *mockServiceService) Reorder(ctx context.Context, ids []int) error {
+	return m.ReorderFunc(ctx, ids)
+}
+
+func TestServiceHandler_GetServices_Error(t *testing.T) {
+	svc := &mockServiceService{
+		GetAllFunc: func(ctx context.Context) ([]model.Service, error) {
+			return nil, errors.New("db error")
+		},
+	}
+	h := NewServiceHandler(svc, nil, noProfile, seo.Site{}, "")
+	r := httptest.NewRequest(http.MethodGet, "/api/services", nil)
+	w := httptest.NewRecorder()
+
+	h.GetServices(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", w.Code)
+	}
+}
+
+func TestServiceHandler_ReorderServices(t *testing.T) {
+	var got []int
+	svc := &mockServiceService{
+		ReorderFunc: func(ctx context.Context, ids []int) error {
+			got = ids
+			return nil
+		},
+	}
+	h := NewServiceHandler(svc, nil, noProfile, seo.Site{}, "")
+	r := httptest.NewRequest(http.MethodPut, "/api/services/order", bytes.NewReader([]byte(`{"ids":[2,1]}`)))
+	w := httptest.NewRecorder()
+
+	h.ReorderServices(w, r)
+	if w.Code != http.StatusNoContent || len(got) != 2 || got[0] != 2 {
+		t.Errorf("expected 204 with ids [2 1], got %d with %v", w.Code, got)
+	}
+}
+
+func TestServiceHandler_RenderServicesPage(t *testing.T) {
+	svc := &mockServiceService{
+		GetAllFunc: func(ctx context.Context) ([]model.Service, error) {
+			return []model.Service{{ID: 1, Title: "Go consulting", Icon: "img/services/s1.png", Description: "APIs"}}, nil
+		},
+	}
+	ts := &mockTestimonialService{
+		GetApprovedFunc: func(ctx context.Context) ([]model.Testimonial, error) {
+			return []model.Testimonial{{ID: 1, Author: "Happy Client", Quote: "Great", Rating: 4}}, nil
+		},
+	}
+	h := NewServiceHandler(svc, ts, noProfile, seo.Site{}, "../WebView")
+	r := httptest.NewRequest(http.MethodGet, "/services", nil)
+	w := httptest.NewRecorder()
+
+	h.RenderServicesPage(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "Go consulting") {
+		t.Error("expected service title in rendered page")
+	}
+	if strings.Contains(w.Body.String(), "Wp developing") {
+		t.Error("expected hard-coded services to be gone")
+	}
+	if !strings.Contains(w.Body.String(), "Happy Client") || strings.Contains(w.Body.String(), "Elite Martin") {
+		t.Error("expected only approved testimonials in the carousel")
+	}
+}
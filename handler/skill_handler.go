@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+	"porto/model"
+	"porto/service"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type SkillHandler struct {
+	Service service.SkillService
+}
+
+func NewSkillHandler(s service.SkillService) *SkillHandler {
+	return &SkillHandler{Service: s}
+}
+
+func (h *SkillHandler) GetSkills(w http.ResponseWriter, r *http.Request) {
+	skills, err := h.Service.GetAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetSkills error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "GetSkills success", "component", "SkillHandler", "count", len(skills))
+	json.NewEncoder(w).Encode(skills)
+}
+
+// GetSkillUsage serves the skills matrix; ?name=Go limits it to one skill.
+func (h *SkillHandler) GetSkillUsage(w http.ResponseWriter, r *http.Request) {
+	usage, err := h.Service.GetUsage(r.Context(), r.URL.Query().Get("name"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetSkillUsage error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "GetSkillUsage success", "component", "SkillHandler", "count", len(usage))
+	json.NewEncoder(w).Encode(usage)
+}
+
+func (h *SkillHandler) CreateSkill(w http.ResponseWriter, r *http.Request) {
+	var s model.Skill
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		slog.WarnContext(r.Context(), "CreateSkill decode error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Create(r.Context(), &s); err != nil {
+		slog.ErrorContext(r.Context(), "CreateSkill service error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "CreateSkill success", "component", "SkillHandler", "id", s.ID)
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(s)
+}
+
+func (h *SkillHandler) UpdateSkill(w http.ResponseWriter, r *http.Request) {
+	var s model.Skill
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		slog.WarnContext(r.Context(), "UpdateSkill decode error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Update(r.Context(), &s); err != nil {
+		slog.ErrorContext(r.Context(), "UpdateSkill service error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "UpdateSkill success", "component", "SkillHandler", "id", s.ID)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(s)
+}
+
+func (h *SkillHandler) DeleteSkill(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "DeleteSkill invalid id", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Delete(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "DeleteSkill service error", "component", "SkillHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "DeleteSkill success", "component", "SkillHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"porto/model"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type mockSkillService struct {
+	GetUsageFunc func(ctx context.Context, name string) ([]model.SkillUsage, error)
+	CreateFunc   func(ctx context.Context, s *model.Skill) error
+	DeleteFunc   func(ctx context.Context, id int) error
+}
+
+func (m *mockSkillService) GetAll(ctx context.Context) ([]model.Skill, error) { return nil, nil }
+func (m *mockSkillService) GetByID(ctx context.Context, id int) (*model.Skill, error) {
+	return nil, nil
+}
+func (m *mockSkillService) Create(ctx context.Context, s *model.Skill) error {
+	return m.CreateFunc(ctx, s)
+}
+func (m *mockSkillService) Update(ctx context.Context, _ *model.Skill) error { return nil }
+func (m *mockSkillService) Delete(ctx context.Context, id int) error {
+	return m.DeleteFunc(ctx, id)
+}
+func (m *mockSkillService) GetUsage(ctx context.Context, name string) ([]model.SkillUsage, error) {
+	return m.GetUsageFunc(ctx, name)
+}
+
+func TestSkillHandler_GetSkillUsage(t *testing.T) {
+	var gotName string
+	svc := &mockSkillService{
+		GetUsageFunc: func(ctx context.Context, name string) ([]model.SkillUsage, error) {
+			gotName = name
+			return []model.SkillUsage{{Skill: model.Skill{ID: 1, Name: "Go"}}}, nil
+		},
+	}
+	h := NewSkillHandler(svc)
+	r := httptest.NewRequest(http.MethodGet, "/api/skills/usage?name=Go", nil)
+	w := httptest.NewRecorder()
+
+	h.GetSkillUsage(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+	if gotName != "Go" {
+		t.Errorf("expected name filter Go, got %q", gotName)
+	}
+}
+
+func TestSkillHandler_CreateSkill_ServiceError(t *testing.T) {
+	svc := &mockSkillService{
+		CreateFunc: func(ctx context.Context, s *model.Skill) error {
+			return errors.New("service error")
+		},
+	}
+	h := NewSkillHandler(svc)
+	body, _ := json.Marshal(model.Skill{Name: "Go"})
+	r := httptest.NewRequest(http.MethodPost, "/api/skills", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.CreateSkill(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", w.Code)
+	}
+}
+
+func TestSkillHandler_DeleteSkill(t *testing.T) {
+	var gotID int
+	svc := &mockSkillService{
+		DeleteFunc: func(ctx context.Context, id int) error {
+			gotID = id
+			return nil
+		},
+	}
+	h := NewSkillHandler(svc)
+	router := chi.NewRouter()
+	router.Delete("/api/skills/{id}", h.DeleteSkill)
+	r := httptest.NewRequest(http.MethodDelete, "/api/skills/7", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, r)
+	if w.Code != http.StatusNoContent || gotID != 7 {
+		t.Errorf("expected 204 for id 7, got %d for id %d", w.Code, gotID)
+	}
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"porto/i18n"
+	"porto/middleware"
+	"porto/model"
+	"porto/seo"
+	"porto/service"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SubscriberHandler struct {
+	Service        service.SubscriberService
+	ProfileService service.ProfileService
+	TemplateDir    string
+}
+
+func NewSubscriberHandler(s service.SubscriberService, ps service.ProfileService, templateDir string) *SubscriberHandler {
+	return &SubscriberHandler{Service: s, ProfileService: ps, TemplateDir: templateDir}
+}
+
+// Subscribe accepts {"email": "..."} as JSON, or the "email" field of the
+// newsletter form, which is redirected back to the page it was sent from.
+func (h *SubscriberHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	isJSON := strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+	var body struct {
+		Email string `json:"email"`
+	}
+	if isJSON {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			slog.WarnContext(r.Context(), "Subscribe decode error", "component", "SubscriberHandler", "error", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	} else {
+		body.Email = r.FormValue("email")
+	}
+	if err := h.Service.Subscribe(r.Context(), body.Email); err != nil {
+		slog.ErrorContext(r.Context(), "Subscribe service error", "component", "SubscriberHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "Subscribe success", "component", "SubscriberHandler")
+	if !isJSON {
+		http.Redirect(w, r, backTo(r), http.StatusSeeOther)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Check your inbox to confirm your subscription."})
+}
+
+// Confirm shows a button that confirms the subscription of ?token= by POST,
+// so link scanners and prefetchers opening the e-mailed link change nothing.
+func (h *SubscriberHandler) Confirm(w http.ResponseWriter, r *http.Request) {
+	h.renderTokenPage(w, r, "Confirm", "newsletter.confirm", h.Service.Confirm)
+}
+
+// Unsubscribe works like Confirm.
+func (h *SubscriberHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	h.renderTokenPage(w, r, "Unsubscribe", "newsletter.unsubscribe", h.Service.Unsubscribe)
+}
+
+// NewsletterPageData is the confirm and unsubscribe page. Token is set while
+// the action waits for its POST.
+type NewsletterPageData struct {
+	Title     string
+	Message   string
+	Action    string
+	Button    string
+	Token     string
+	CSRFToken string
+	Static    string
+	Profile   model.Profile
+	Meta      seo.Meta
+}
+
+// renderTokenPage asks for the action on GET and applies it to the posted
+// token on POST. key prefixes the page's catalog entries.
+func (h *SubscriberHandler) renderTokenPage(w http.ResponseWriter, r *http.Request, op, key string, apply func(ctx context.Context, token string) error) {
+	locale := i18n.Locale(r.Context())
+	data := NewsletterPageData{
+		Title:     i18n.T(locale, key+".title"),
+		Action:    r.URL.Path,
+		CSRFToken: middleware.CSRFToken(r),
+		Static:    "/static",
+	}
+	data.Meta = seo.Meta{Title: data.Title, Type: "website"}
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		err := apply(r.Context(), r.PostFormValue("token"))
+		switch {
+		case err == nil:
+			slog.InfoContext(r.Context(), op+" success", "component", "SubscriberHandler")
+			data.Message = i18n.T(locale, key+".done")
+		case errors.Is(err, sql.ErrNoRows):
+			slog.WarnContext(r.Context(), op+" service error", "component", "SubscriberHandler", "error", err)
+			data.Message = i18n.T(locale, "newsletter.invalid_link")
+			status = http.StatusNotFound
+		default:
+			slog.ErrorContext(r.Context(), op+" service error", "component", "SubscriberHandler", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	} else if data.Token = r.URL.Query().Get("token"); data.Token == "" {
+		data.Message = i18n.T(locale, "newsletter.invalid_link")
+		status = http.StatusNotFound
+	} else {
+		data.Message = i18n.T(locale, key+".prompt")
+		data.Button = i18n.T(locale, key+".button")
+	}
+	var err error
+	if data.Profile, err = loadProfile(r, h.ProfileService); err != nil {
+		slog.ErrorContext(r.Context(), op+" profile error", "component", "SubscriberHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	tmpl, err := parsePage(h.TemplateDir, "newsletter", locale)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "template parse error", "component", "SubscriberHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		slog.ErrorContext(r.Context(), "template execute error", "component", "SubscriberHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(status)
+	buf.WriteTo(w)
+}
+
+// GetSubscribers lists subscribers with the given ?status= (default confirmed).
+func (h *SubscriberHandler) GetSubscribers(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status == "" {
+		status = model.SubscriberConfirmed
+	}
+	subscribers, err := h.Service.GetByStatus(r.Context(), status)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetSubscribers error", "component", "SubscriberHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "GetSubscribers success", "component", "SubscriberHandler", "count", len(subscribers))
+	json.NewEncoder(w).Encode(subscribers)
+}
+
+// ExportSubscribers downloads confirmed subscribers as CSV.
+func (h *SubscriberHandler) ExportSubscribers(w http.ResponseWriter, r *http.Request) {
+	subscribers, err := h.Service.GetByStatus(r.Context(), model.SubscriberConfirmed)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "ExportSubscribers error", "component", "SubscriberHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="subscribers.csv"`)
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"id", "email", "subscribed_at", "confirmed_at"})
+	for _, s := range subscribers {
+		confirmedAt := ""
+		if s.ConfirmedAt != nil {
+			confirmedAt = s.ConfirmedAt.UTC().Format(time.RFC3339)
+		}
+		cw.Write([]string{strconv.Itoa(s.ID), s.Email, s.CreatedAt.UTC().Format(time.RFC3339), confirmedAt})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		slog.ErrorContext(r.Context(), "ExportSubscribers write error", "component", "SubscriberHandler", "error", err)
+		return
+	}
+	slog.InfoContext(r.Context(), "ExportSubscribers success", "component", "SubscriberHandler", "count", len(subscribers))
+}
+
+// backTo returns the local page a form was posted from, or "/".
+func backTo(r *http.Request) string {
+	ref, err := url.Parse(r.Referer())
+	if err != nil || ref.Path == "" || (ref.Host != "" && ref.Host != r.Host) {
+		return "/"
+	}
+	return ref.Path
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"porto/model"
+)
+
+type mockSubscriberService struct {
+	SubscribeFunc   func(ctx context.Context, email string) error
+	ConfirmFunc     func(ctx context.Context, token string) error
+	GetByStatusFunc func(ctx context.Context, status string) ([]model.Subscriber, error)
+}
+
+func (m *mockSubscriberService) Subscribe(ctx context.Context, email string) error {
+	return m.SubscribeFunc(ctx, email)
+}
+func (m *mockSubscriberService) Confirm(ctx context.Context, token string) error {
+	return m.ConfirmFunc(ctx, token)
+}
+func (m *mockSubscriberService) Unsubscribe(ctx context.Context, _ string) error { return nil }
+func (m *mockSubscriberService) GetByStatus(ctx context.Context, status string) ([]model.Subscriber, error) {
+	return m.GetByStatusFunc(ctx, status)
+}
+
+func TestSubscriberHandler_Subscribe_Form(t *testing.T) {
+	var got string
+	svc := &mockSubscriberService{SubscribeFunc: func(ctx context.Context, email string) error {
+		got = email
+		return nil
+	}}
+	h := NewSubscriberHandler(svc, noProfile, "../WebView")
+	form := url.Values{"email": {"a@mail.com"}}
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/subscribe", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("Referer", "http://example.com/about")
+	w := httptest.NewRecorder()
+
+	h.Subscribe(w, r)
+	if w.Code != http.StatusSeeOther || got != "a@mail.com" {
+		t.Errorf("expected 303 for a@mail.com, got %d for %q", w.Code, got)
+	}
+	if loc := w.Header().Get("Location"); loc != "/about" {
+		t.Errorf("expected redirect to /about, got %q", loc)
+	}
+}
+
+func TestSubscriberHandler_Confirm(t *testing.T) {
+	var got string
+	svc := &mockSubscriberService{ConfirmFunc: func(ctx context.Context, token string) error {
+		got = token
+		return nil
+	}}
+	h := NewSubscriberHandler(svc, noProfile, "../WebView")
+
+	// opening the link only asks
+	w := httptest.NewRecorder()
+	h.Confirm(w, httptest.NewRequest(http.MethodGet, "/newsletter/confirm?token=abc", nil))
+	if w.Code != http.StatusOK || got != "" || !strings.Contains(w.Body.String(), `name="token" value="abc"`) {
+		t.Fatalf("expected a confirmation form and no change, got %d, token %q", w.Code, got)
+	}
+
+	// posting the form confirms
+	form := url.Values{"token": {"abc"}}
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/confirm", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	h.Confirm(w, r)
+	if w.Code != http.StatusOK || got != "abc" || !strings.Contains(w.Body.String(), "Your subscription is confirmed") {
+		t.Errorf("expected abc to be confirmed, got %d, token %q", w.Code, got)
+	}
+}
+
+func TestSubscriberHandler_Confirm_UnknownToken(t *testing.T) {
+	svc := &mockSubscriberService{ConfirmFunc: func(ctx context.Context, token string) error {
+		return sql.ErrNoRows
+	}}
+	h := NewSubscriberHandler(svc, noProfile, "../WebView")
+	form := url.Values{"token": {"nope"}}
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/confirm", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+
+	h.Confirm(w, r)
+	if w.Code != http.StatusNotFound || !strings.Contains(w.Body.String(), "This link is invalid.") {
+		t.Errorf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestSubscriberHandler_ExportSubscribers(t *testing.T) {
+	confirmed := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	svc := &mockSubscriberService{GetByStatusFunc: func(ctx context.Context, status string) ([]model.Subscriber, error) {
+		if status != model.SubscriberConfirmed {
+			t.Errorf("expected confirmed status, got %q", status)
+		}
+		return []model.Subscriber{{ID: 1, Email: "a@mail.com", CreatedAt: confirmed, ConfirmedAt: &confirmed}}, nil
+	}}
+	h := NewSubscriberHandler(svc, noProfile, "../WebView")
+	w := httptest.NewRecorder()
+
+	h.ExportSubscribers(w, httptest.NewRequest(http.MethodGet, "/api/admin/subscribers/export", nil))
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Errorf("expected text/csv, got %q", ct)
+	}
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil || len(records) != 2 || records[1][1] != "a@mail.com" {
+		t.Errorf("expected header and one row, got %v, err %v", records, err)
+	}
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"porto/i18n"
+)
+
+// pageTemplates lists the files each page handler parses, keyed by page.
+// The first file is the one executed.
+var pageTemplates = map[string][]string{
+	"home":      {"index.html", "meta.html"},
+	"portfolio": {"portfolio.html", "meta.html"},
+	"project":   {"portfolio-details.html", "meta.html"},
+	"about":     {"about.html", "meta.html"},
+	"services":  {"services.html", "meta.html"},
+	"contact":   {"contact.html", "layout.html", "meta.html"},
+	// Confirm and unsubscribe links of the newsletter
+	"newsletter": {"newsletter.html", "layout.html", "meta.html"},
+}
+
+// parsePage parses the templates of page with the i18n functions bound to
+// locale.
+func parsePage(dir, page, locale string) (*template.Template, error) {
+	files := pageTemplates[page]
+	paths := make([]string, len(files))
+	for i, f := range files {
+		paths[i] = filepath.Join(dir, f)
+	}
+	return template.New(files[0]).Funcs(i18n.Funcs(locale)).ParseFiles(paths...)
+}
+
+// CheckTemplates parses every page template in dir so a broken or missing
+// template is reported at startup instead of on the first request.
+func CheckTemplates(dir string) error {
+	for page := range pageTemplates {
+		if _, err := parsePage(dir, page, i18n.Default); err != nil {
+			return fmt.Errorf("%s page: %w", page, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"porto/i18n"
+	"porto/model"
+	"porto/service"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type TestimonialHandler struct {
+	Service service.TestimonialService
+}
+
+func NewTestimonialHandler(s service.TestimonialService) *TestimonialHandler {
+	return &TestimonialHandler{Service: s}
+}
+
+// GetTestimonials is public and only lists approved testimonials.
+func (h *TestimonialHandler) GetTestimonials(w http.ResponseWriter, r *http.Request) {
+	testimonials, err := h.Service.GetApproved(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetTestimonials error", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "GetTestimonials success", "component", "TestimonialHandler", "count", len(testimonials))
+	json.NewEncoder(w).Encode(testimonials)
+}
+
+func (h *TestimonialHandler) SubmitTestimonial(w http.ResponseWriter, r *http.Request) {
+	var t model.Testimonial
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		slog.WarnContext(r.Context(), "SubmitTestimonial decode error", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Submit(r.Context(), &t); err != nil {
+		slog.ErrorContext(r.Context(), "SubmitTestimonial service error", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "SubmitTestimonial success", "component", "TestimonialHandler", "id", t.ID)
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(t)
+}
+
+// GetModerationQueue lists testimonials for admins, filtered by ?status=.
+func (h *TestimonialHandler) GetModerationQueue(w http.ResponseWriter, r *http.Request) {
+	testimonials, err := h.Service.GetAll(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "GetModerationQueue error", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(i18n.Error(r.Context(), err)))
+		return
+	}
+	slog.InfoContext(r.Context(), "GetModerationQueue success", "component", "TestimonialHandler", "count", len(testimonials))
+	json.NewEncoder(w).Encode(testimonials)
+}
+
+func (h *TestimonialHandler) ApproveTestimonial(w http.ResponseWriter, r *http.Request) {
+	h.moderate(w, r, "ApproveTestimonial", h.Service.Approve)
+}
+
+func (h *TestimonialHandler) RejectTestimonial(w http.ResponseWriter, r *http.Request) {
+	h.moderate(w, r, "RejectTestimonial", h.Service.Reject)
+}
+
+func (h *TestimonialHandler) moderate(w http.ResponseWriter, r *http.Request, op string, apply func(ctx context.Context, id int) error) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), op+" invalid id", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := apply(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), op+" service error", "component", "TestimonialHandler", "error", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), op+" success", "component", "TestimonialHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *TestimonialHandler) DeleteTestimonial(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		slog.WarnContext(r.Context(), "DeleteTestimonial invalid id", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := h.Service.Delete(r.Context(), id); err != nil {
+		slog.ErrorContext(r.Context(), "DeleteTestimonial service error", "component", "TestimonialHandler", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "DeleteTestimonial success", "component", "TestimonialHandler", "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
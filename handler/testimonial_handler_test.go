@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"porto/model"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type mockTestimonialService struct {
+	GetApprovedFunc func(ctx context.Context) ([]model.Testimonial, error)
+	SubmitFunc      func(ctx context.Context, t *model.Testimonial) error
+	ApproveFunc     func(ctx context.Context, id int) error
+}
+
+func (m *mockTestimonialService) GetAll(ctx context.Context, status string) ([]model.Testimonial, error) {
+	return nil, nil
+}
+func (m *mockTestimonialService) GetApproved(ctx context.Context) ([]model.Testimonial, error) {
+	return m.GetApprovedFunc(ctx)
+}
+func (m *mockTestimonialService) GetByID(ctx context.Context, id int) (*model.Testimonial, error) {
+	return nil, nil
+}
+func (m *mockTestimonialService) Submit(ctx context.Context, t *model.Testimonial) error {
+	return m.SubmitFunc(ctx, t)
+}
+func (m *mockTestimonialService) Approve(ctx context.Context, id int) error {
+	return m.ApproveFunc(ctx, id)
+}
+func (m *mockTestimonialService) Reject(ctx context.Context, _ int) error { return nil }
+func (m *mockTestimonialService) Delete(ctx context.Context, _ int) error { return nil }
+
+func TestTestimonialHandler_SubmitTestimonial(t *testing.T) {
+	svc := &mockTestimonialService{
+		SubmitFunc: func(ctx context.Context, t *model.Testimonial) error {
+			t.ID = 1
+			t.Status = model.TestimonialPending
+			return nil
+		},
+	}
+	h := NewTestimonialHandler(svc)
+	body, _ := json.Marshal(model.Testimonial{Author: "A", Quote: "Great", Rating: 5})
+	r := httptest.NewRequest(http.MethodPost, "/api/testimonials", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.SubmitTestimonial(w, r)
+	if w.Code != http.StatusAccepted {
+		t.Errorf("expected 202, got %d", w.Code)
+	}
+}
+
+func TestTestimonialHandler_ApproveTestimonial(t *testing.T) {
+	svc := &mockTestimonialService{
+		ApproveFunc: func(ctx context.Context, id int) error {
+			if id != 1 {
+				return sql.ErrNoRows
+			}
+			return nil
+		},
+	}
+	h := NewTestimonialHandler(svc)
+	router := chi.NewRouter()
+	router.Post("/api/admin/testimonials/{id}/approve", h.ApproveTestimonial)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/admin/testimonials/1/approve", nil))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/admin/testimonials/2/approve", nil))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", w.Code)
+	}
+}
@@ -0,0 +1,129 @@
+// Package health serves the liveness (/healthz) and readiness (/readyz)
+// endpoints used by the orchestrator.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"porto/migrations"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Check reports whether a dependency is usable.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs the readiness checks. Liveness only reports that the process
+// is serving requests.
+type Checker struct {
+	timeout  time.Duration
+	checks   []namedCheck
+	draining atomic.Bool
+}
+
+// NewChecker gives every readiness probe timeout to run all of its checks.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add registers a readiness check. Checks run in the order they were added.
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name, check})
+}
+
+// Drain makes readiness fail from now on so the instance is taken out of
+// rotation before the server shuts down.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Healthz always answers 200 while the process is up.
+func (c *Checker) Healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// Readyz answers 200 when every check passes and 503 otherwise, listing
+// the result of each check.
+func (c *Checker) Readyz(w http.ResponseWriter, r *http.Request) {
+	status, code := "ok", http.StatusOK
+	results := make(map[string]string, len(c.checks))
+	if c.draining.Load() {
+		status, code = "shutting down", http.StatusServiceUnavailable
+	} else {
+		ctx, cancel := context.WithTimeout(r.Context(), c.timeout)
+		defer cancel()
+		for _, nc := range c.checks {
+			if err := nc.check(ctx); err != nil {
+				slog.WarnContext(r.Context(), "Readiness check failed", "component", "health", "check", nc.name, "error", err)
+				results[nc.name] = err.Error()
+				status, code = "unavailable", http.StatusServiceUnavailable
+				continue
+			}
+			results[nc.name] = "ok"
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]any{"status": status, "checks": results})
+}
+
+// DB pings the database.
+func DB(db *sql.DB) Check {
+	return db.PingContext
+}
+
+// Migrations fails while any embedded migration has not been applied.
+func Migrations(db *sql.DB) Check {
+	return func(ctx context.Context) error {
+		pending, err := migrations.Pending(ctx, db)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("pending migrations: %s", strings.Join(pending, ", "))
+		}
+		return nil
+	}
+}
+
+// Static reports an error found once at startup, such as templates that
+// failed to parse.
+func Static(err error) Check {
+	return func(context.Context) error { return err }
+}
+
+// Wait runs check until it succeeds, doubling the delay between attempts
+// from initial up to max. It gives up after attempts tries or when ctx is
+// done and returns the last error.
+func Wait(ctx context.Context, check Check, attempts int, initial, max time.Duration) error {
+	delay := initial
+	var err error
+	for i := 1; i <= attempts; i++ {
+		if err = check(ctx); err == nil {
+			return nil
+		}
+		if i == attempts {
+			break
+		}
+		slog.WarnContext(ctx, "Dependency not ready, retrying", "component", "health", "attempt", i, "delay", delay, "error", err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, max)
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChecker_Readyz(t *testing.T) {
+	c := NewChecker(time.Second)
+	var dbErr error
+	c.Add("db", func(ctx context.Context) error { return dbErr })
+	c.Add("templates", Static(nil))
+
+	w := httptest.NewRecorder()
+	c.Readyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+
+	dbErr = errors.New("connection refused")
+	w = httptest.NewRecorder()
+	c.Readyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var body struct {
+		Checks map[string]string `json:"checks"`
+	}
+	json.NewDecoder(w.Body).Decode(&body)
+	if w.Code != http.StatusServiceUnavailable || body.Checks["db"] != "connection refused" || body.Checks["templates"] != "ok" {
+		t.Errorf("expected 503 with the failing check, got %d %v", w.Code, body.Checks)
+	}
+
+	dbErr = nil
+	c.Drain()
+	w = httptest.NewRecorder()
+	c.Readyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 while draining, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	c.Healthz(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("expected healthz to stay 200, got %d", w.Code)
+	}
+}
+
+func TestWait(t *testing.T) {
+	calls := 0
+	check := func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	}
+	if err := Wait(context.Background(), check, 5, time.Millisecond, 2*time.Millisecond); err != nil || calls != 3 {
+		t.Errorf("expected success on the third attempt, got %v after %d calls", err, calls)
+	}
+
+	calls = 0
+	fail := func(ctx context.Context) error { calls++; return errors.New("down") }
+	if err := Wait(context.Background(), fail, 2, time.Millisecond, time.Millisecond); err == nil || calls != 2 {
+		t.Errorf("expected failure after 2 attempts, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,110 @@
+// Package i18n translates the rendered pages and validation messages into
+// the supported locales and picks the locale of each request.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Default is the locale used when a request asks for none of Supported and
+// the fallback for messages missing from a catalog.
+const Default = "en"
+
+// Supported lists the locales with a message catalog.
+var Supported = []string{"en", "id"}
+
+//go:embed locales/*.json
+var files embed.FS
+
+// catalogs maps a locale to its messages, keyed by message key.
+var catalogs = mustLoad()
+
+func mustLoad() map[string]map[string]string {
+	catalogs := make(map[string]map[string]string, len(Supported))
+	for _, locale := range Supported {
+		b, err := files.ReadFile(path.Join("locales", locale+".json"))
+		if err != nil {
+			panic(err)
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(b, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: %s catalog: %v", locale, err))
+		}
+		catalogs[locale] = messages
+	}
+	return catalogs
+}
+
+// Supports reports whether locale has a catalog.
+func Supports(locale string) bool {
+	return slices.Contains(Supported, locale)
+}
+
+// T returns the message for key in locale, formatted with args as by
+// fmt.Sprintf. A message missing from the catalog falls back to Default,
+// then to the key itself.
+func T(locale, key string, args ...any) string {
+	msg, ok := catalogs[locale][key]
+	if !ok {
+		msg, ok = catalogs[Default][key]
+	}
+	if !ok {
+		msg = key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Funcs returns the template functions for locale: t translates a key and
+// locale returns the locale, e.g. for the lang attribute.
+func Funcs(locale string) template.FuncMap {
+	return template.FuncMap{
+		"t":      func(key string, args ...any) string { return T(locale, key, args...) },
+		"locale": func() string { return locale },
+	}
+}
+
+type contextKey struct{}
+
+// WithLocale returns a context carrying locale.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// Locale returns the locale stored by Middleware, or Default.
+func Locale(ctx context.Context) string {
+	if locale, ok := ctx.Value(contextKey{}).(string); ok {
+		return locale
+	}
+	return Default
+}
+
+// Localizer is implemented by errors whose message can be translated.
+type Localizer interface {
+	Localize(locale string) string
+}
+
+// Error returns the message of err in the locale of ctx. When the
+// translatable error is wrapped, e.g. "project 2: name is required", the
+// wrapping prefix is kept.
+func Error(ctx context.Context, err error) string {
+	var l Localizer
+	if !errors.As(err, &l) {
+		return err.Error()
+	}
+	prefix, ok := strings.CutSuffix(err.Error(), l.Localize(Default))
+	if !ok {
+		prefix = ""
+	}
+	return prefix + l.Localize(Locale(ctx))
+}
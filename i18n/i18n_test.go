@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCatalogsHaveTheSameKeys(t *testing.T) {
+	for _, locale := range Supported {
+		for key := range catalogs[Default] {
+			if _, ok := catalogs[locale][key]; !ok {
+				t.Errorf("%s catalog is missing %q", locale, key)
+			}
+		}
+		for key := range catalogs[locale] {
+			if _, ok := catalogs[Default][key]; !ok {
+				t.Errorf("%s catalog has %q, which %s does not", locale, key, Default)
+			}
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	if got := T("id", "nav.home"); got != "Beranda" {
+		t.Errorf("expected the Indonesian message, got %q", got)
+	}
+	if got := T("id", "testimonials.rating", 4); got != "4 dari 5" {
+		t.Errorf("expected the arguments to be formatted, got %q", got)
+	}
+	if got := T("fr", "nav.home"); got != "Home" {
+		t.Errorf("expected a fallback to %s, got %q", Default, got)
+	}
+	if got := T("id", "no.such.key"); got != "no.such.key" {
+		t.Errorf("expected the key for a missing message, got %q", got)
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	cases := []struct {
+		header string
+		want   string
+		ok     bool
+	}{
+		{"id-ID,id;q=0.9,en;q=0.8", "id", true},
+		{"en-US,en;q=0.9", "en", true},
+		{"fr-FR, en;q=0.5, id;q=0.7", "id", true},
+		{"de, id;q=0", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		got, ok := FromAcceptLanguage(c.header)
+		if got != c.want || ok != c.ok {
+			t.Errorf("%q: got %q %v, want %q %v", c.header, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var path, locale string
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, locale = r.URL.Path, Locale(r.Context())
+	}))
+
+	// a prefix is stripped and remembered
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/id/portfolio", nil))
+	if path != "/portfolio" || locale != "id" {
+		t.Errorf("expected /portfolio in id, got %s in %s", path, locale)
+	}
+	if c := w.Result().Cookies(); len(c) != 1 || c[0].Name != CookieName || c[0].Value != "id" {
+		t.Errorf("expected the locale cookie, got %v", c)
+	}
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/en", nil))
+	if path != "/" || locale != "en" {
+		t.Errorf("expected / in en, got %s in %s", path, locale)
+	}
+
+	// the cookie wins over Accept-Language
+	r := httptest.NewRequest(http.MethodGet, "/about", nil)
+	r.AddCookie(&http.Cookie{Name: CookieName, Value: "id"})
+	r.Header.Set("Accept-Language", "en")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if path != "/about" || locale != "id" {
+		t.Errorf("expected /about in id from the cookie, got %s in %s", path, locale)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/identity", nil)
+	r.Header.Set("Accept-Language", "id-ID")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if path != "/identity" || locale != "id" {
+		t.Errorf("expected /identity untouched in id, got %s in %s", path, locale)
+	}
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if locale != Default {
+		t.Errorf("expected %s, got %s", Default, locale)
+	}
+}
+
+type localized string
+
+func (l localized) Error() string                 { return T(Default, string(l)) }
+func (l localized) Localize(locale string) string { return T(locale, string(l)) }
+
+func TestError(t *testing.T) {
+	ctx := WithLocale(context.Background(), "id")
+	if got := Error(ctx, localized("nav.home")); got != "Beranda" {
+		t.Errorf("expected the translated message, got %q", got)
+	}
+	if got := Error(ctx, fmt.Errorf("project 2: %w", localized("nav.home"))); got != "project 2: Beranda" {
+		t.Errorf("expected the wrapping prefix to be kept, got %q", got)
+	}
+	if got := Error(ctx, fmt.Errorf("plain")); got != "plain" {
+		t.Errorf("expected other errors unchanged, got %q", got)
+	}
+}
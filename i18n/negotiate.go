@@ -0,0 +1,97 @@
+package i18n
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CookieName is the cookie remembering the locale picked by URL prefix.
+const CookieName = "lang"
+
+// Middleware picks the locale of each request and stores it in the request
+// context. In order of precedence:
+//
+//   - a locale path prefix, e.g. "/id/portfolio", which is stripped before
+//     routing and remembered in a cookie so unprefixed links keep it;
+//   - the cookie;
+//   - the Accept-Language header;
+//   - Default.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locale, rest, prefixed := cutPrefix(r.URL.Path)
+		if prefixed {
+			r = r.Clone(r.Context())
+			r.URL.Path = rest
+			r.URL.RawPath = ""
+			if c, err := r.Cookie(CookieName); err != nil || c.Value != locale {
+				http.SetCookie(w, &http.Cookie{
+					Name: CookieName, Value: locale, Path: "/",
+					MaxAge: int((365 * 24 * time.Hour).Seconds()), SameSite: http.SameSiteLaxMode,
+				})
+			}
+		} else {
+			locale = negotiate(r)
+		}
+		w.Header().Add("Vary", "Accept-Language, Cookie")
+		next.ServeHTTP(w, r.WithContext(WithLocale(r.Context(), locale)))
+	})
+}
+
+// cutPrefix splits "/id/portfolio" into "id" and "/portfolio". "/id" alone
+// becomes "/".
+func cutPrefix(p string) (locale, rest string, ok bool) {
+	for _, l := range Supported {
+		if p == "/"+l {
+			return l, "/", true
+		}
+		if rest, ok := strings.CutPrefix(p, "/"+l+"/"); ok {
+			return l, "/" + rest, true
+		}
+	}
+	return "", p, false
+}
+
+func negotiate(r *http.Request) string {
+	if c, err := r.Cookie(CookieName); err == nil && Supports(c.Value) {
+		return c.Value
+	}
+	if locale, ok := FromAcceptLanguage(r.Header.Get("Accept-Language")); ok {
+		return locale
+	}
+	return Default
+}
+
+// FromAcceptLanguage returns the supported locale the header prefers most,
+// matching on the primary language, e.g. "id-ID" selects "id". Ranges with
+// q=0 are ignored.
+func FromAcceptLanguage(header string) (string, bool) {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var candidates []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		if q > 0 && Supports(primary) {
+			candidates = append(candidates, weighted{primary, q})
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	// The stable sort keeps the header order among equal weights.
+	slices.SortStableFunc(candidates, func(a, b weighted) int { return cmp.Compare(b.q, a.q) })
+	return candidates[0].locale, true
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"porto/mailer"
+)
+
+// KindSendMail jobs deliver a mailer.Message.
+const KindSendMail = "send_mail"
+
+// Enqueuer is satisfied by service.JobService.
+type Enqueuer interface {
+	Enqueue(ctx context.Context, kind string, payload any) error
+}
+
+// QueueMailer implements mailer.Mailer by storing each message as a job,
+// so delivery is retried and survives restarts.
+type QueueMailer struct {
+	queue Enqueuer
+}
+
+func NewQueueMailer(queue Enqueuer) *QueueMailer {
+	return &QueueMailer{queue: queue}
+}
+
+func (m *QueueMailer) Send(ctx context.Context, msg mailer.Message) error {
+	return m.queue.Enqueue(ctx, KindSendMail, msg)
+}
+
+// SendMail returns the handler for KindSendMail jobs.
+func SendMail(m mailer.Mailer) Handler {
+	return func(ctx context.Context, payload json.RawMessage) error {
+		var msg mailer.Message
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return err
+		}
+		return m.Send(ctx, msg)
+	}
+}
@@ -0,0 +1,122 @@
+// Package jobs runs background work stored in the Postgres jobs table.
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"porto/repository"
+	"sync"
+	"time"
+)
+
+// Handler runs one job. A returned error schedules a retry.
+type Handler func(ctx context.Context, payload json.RawMessage) error
+
+const (
+	// lease is how long a claimed job is hidden from other workers.
+	lease          = 10 * time.Minute
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// Pool polls the queue with a fixed number of workers.
+type Pool struct {
+	repo         repository.JobRepository
+	handlers     map[string]Handler
+	concurrency  int
+	pollInterval time.Duration
+	now          func() time.Time
+}
+
+func NewPool(repo repository.JobRepository, concurrency int, pollInterval time.Duration) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Pool{repo: repo, handlers: map[string]Handler{}, concurrency: concurrency, pollInterval: pollInterval, now: time.Now}
+}
+
+// Register sets the handler for jobs of the given kind. It must be called
+// before Run.
+func (p *Pool) Register(kind string, h Handler) {
+	p.handlers[kind] = h
+}
+
+// Run processes jobs until ctx is cancelled, then waits for running jobs
+// to finish.
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < p.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+	slog.InfoContext(ctx, "Started workers", "component", "JobPool", "count", p.concurrency)
+	wg.Wait()
+	slog.InfoContext(ctx, "Stopped", "component", "JobPool")
+}
+
+func (p *Pool) work(ctx context.Context) {
+	for {
+		ran, err := p.runOne(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Poll error", "component", "JobPool", "error", err)
+		}
+		if ran {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+// runOne claims and runs a single job. It reports whether a job was found.
+func (p *Pool) runOne(ctx context.Context) (bool, error) {
+	job, err := p.repo.Claim(ctx, lease)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Cancelling ctx only stops claiming. A claimed job runs to the end and
+	// records its outcome, or it would stay running until the lease expires
+	// and then run a second time.
+	ctx = context.WithoutCancel(ctx)
+	h, ok := p.handlers[job.Kind]
+	if ok {
+		err = h(ctx, job.Payload)
+	} else {
+		err = fmt.Errorf("no handler for job kind %q", job.Kind)
+	}
+	if err == nil {
+		slog.InfoContext(ctx, "Finished job", "component", "JobPool", "kind", job.Kind, "id", job.ID)
+		return true, p.repo.MarkDone(ctx, job.ID)
+	}
+
+	if job.Attempts >= job.MaxAttempts {
+		slog.ErrorContext(ctx, "Job is dead", "component", "JobPool", "kind", job.Kind, "id", job.ID, "attempts", job.Attempts, "error", err)
+		return true, p.repo.MarkDead(ctx, job.ID, err.Error())
+	}
+	runAt := p.now().Add(backoff(job.Attempts))
+	slog.WarnContext(ctx, "Job failed, retrying", "component", "JobPool", "kind", job.Kind, "id", job.ID, "attempts", job.Attempts, "run_at", runAt, "error", err)
+	return true, p.repo.Reschedule(ctx, job.ID, err.Error(), runAt)
+}
+
+// backoff doubles the delay after every attempt, up to retryMaxDelay.
+func backoff(attempts int) time.Duration {
+	d := retryBaseDelay
+	for i := 1; i < attempts && d < retryMaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, retryMaxDelay)
+}
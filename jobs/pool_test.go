@@ -0,0 +1,137 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"porto/mailer"
+	"porto/model"
+	"testing"
+	"time"
+)
+
+type mockJobRepo struct {
+	claim       []*model.Job
+	done, dead  []int
+	rescheduled map[int]time.Time
+}
+
+func (m *mockJobRepo) GetAll(ctx context.Context, status string) ([]model.Job, error) {
+	return nil, nil
+}
+func (m *mockJobRepo) Enqueue(ctx context.Context, j *model.Job) error { return nil }
+func (m *mockJobRepo) Claim(ctx context.Context, lease time.Duration) (*model.Job, error) {
+	if len(m.claim) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	j := m.claim[0]
+	m.claim = m.claim[1:]
+	j.Attempts++
+	return j, nil
+}
+func (m *mockJobRepo) MarkDone(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.done = append(m.done, id)
+	return nil
+}
+func (m *mockJobRepo) Reschedule(ctx context.Context, id int, lastErr string, runAt time.Time) error {
+	m.rescheduled[id] = runAt
+	return nil
+}
+func (m *mockJobRepo) MarkDead(ctx context.Context, id int, lastErr string) error {
+	m.dead = append(m.dead, id)
+	return nil
+}
+func (m *mockJobRepo) Retry(ctx context.Context, id int) error { return nil }
+
+func TestPool_RunOne(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := &mockJobRepo{
+		claim: []*model.Job{
+			{ID: 1, Kind: "ok", MaxAttempts: 5},
+			{ID: 2, Kind: "fail", Attempts: 2, MaxAttempts: 5},
+			{ID: 3, Kind: "fail", Attempts: 4, MaxAttempts: 5},
+			{ID: 4, Kind: "unknown", Attempts: 4, MaxAttempts: 5},
+		},
+		rescheduled: map[int]time.Time{},
+	}
+	p := NewPool(repo, 1, time.Second)
+	p.now = func() time.Time { return now }
+	p.Register("ok", func(ctx context.Context, payload json.RawMessage) error { return nil })
+	p.Register("fail", func(ctx context.Context, payload json.RawMessage) error { return errors.New("boom") })
+
+	for {
+		ran, err := p.runOne(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !ran {
+			break
+		}
+	}
+	if len(repo.done) != 1 || repo.done[0] != 1 {
+		t.Errorf("expected job 1 done, got %v", repo.done)
+	}
+	if got := repo.rescheduled[2]; !got.Equal(now.Add(2 * time.Minute)) {
+		t.Errorf("expected job 2 retried after 2m, got %v", got)
+	}
+	if len(repo.dead) != 2 || repo.dead[0] != 3 || repo.dead[1] != 4 {
+		t.Errorf("expected jobs 3 and 4 dead, got %v", repo.dead)
+	}
+}
+
+func TestPool_RunOne_FinishesAfterCancel(t *testing.T) {
+	repo := &mockJobRepo{claim: []*model.Job{{ID: 1, Kind: "mail", MaxAttempts: 5}}}
+	p := NewPool(repo, 1, time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	p.Register("mail", func(ctx context.Context, payload json.RawMessage) error {
+		cancel() // shutdown starts while the mail is being sent
+		return nil
+	})
+
+	if ran, err := p.runOne(ctx); !ran || err != nil {
+		t.Fatalf("expected the job to run, got %v %v", ran, err)
+	}
+	if len(repo.done) != 1 || repo.done[0] != 1 {
+		t.Errorf("expected job 1 done, got %v", repo.done)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{1: 30 * time.Second, 2: time.Minute, 3: 2 * time.Minute, 20: time.Hour}
+	for attempts, want := range cases {
+		if got := backoff(attempts); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+type recordingEnqueuer struct {
+	kind    string
+	payload any
+}
+
+func (r *recordingEnqueuer) Enqueue(ctx context.Context, kind string, payload any) error {
+	r.kind, r.payload = kind, payload
+	return nil
+}
+
+func TestQueueMailer_RoundTrip(t *testing.T) {
+	q := &recordingEnqueuer{}
+	msg := mailer.Message{From: "site@mail.com", To: []string{"a@mail.com"}, Subject: "Hello", Text: "hi"}
+	if err := NewQueueMailer(q).Send(context.Background(), msg); err != nil || q.kind != KindSendMail {
+		t.Fatalf("expected %s job, got %q, err %v", KindSendMail, q.kind, err)
+	}
+
+	payload, _ := json.Marshal(q.payload)
+	mem := mailer.NewMemoryMailer()
+	if err := SendMail(mem)(context.Background(), payload); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if sent := mem.Sent(); len(sent) != 1 || sent[0].Subject != "Hello" || sent[0].To[0] != "a@mail.com" {
+		t.Errorf("expected message to be delivered, got %+v", sent)
+	}
+}
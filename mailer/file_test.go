@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := t.TempDir()
+	m := NewFileMailer(dir)
+
+	err := m.Send(context.Background(), Message{
+		From:    "site@example.com",
+		To:      []string{"a@mail.com"},
+		Subject: "Hello",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 message file, got %d", len(files))
+	}
+	data, _ := os.ReadFile(files[0])
+	for _, want := range []string{"Subject: Hello", "To: a@mail.com", "multipart/alternative", "plain body", "<p>html body</p>"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected message to contain %q", want)
+		}
+	}
+}
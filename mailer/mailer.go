@@ -0,0 +1,84 @@
+// Package mailer sends e-mail through a pluggable backend so services can
+// notify people without caring how the message is delivered.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+type Message struct {
+	From    string
+	To      []string
+	ReplyTo string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// ErrHeaderLineBreak is returned by Bytes for a header value with CR or LF,
+// which would let the value inject headers or a body.
+var ErrHeaderLineBreak = errors.New("mailer: header value contains a line break")
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Bytes renders the message as an RFC 5322 document. When both Text and HTML
+// are set it is sent as multipart/alternative. A non-ASCII Subject is sent
+// as an RFC 2047 encoded word.
+func (m Message) Bytes() ([]byte, error) {
+	for _, v := range append([]string{m.From, m.ReplyTo, m.Subject}, m.To...) {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, ErrHeaderLineBreak
+		}
+	}
+	var buf bytes.Buffer
+	header := func(k, v string) {
+		if v != "" {
+			fmt.Fprintf(&buf, "%s: %s\r\n", k, v)
+		}
+	}
+	header("From", m.From)
+	header("To", strings.Join(m.To, ", "))
+	header("Reply-To", m.ReplyTo)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	switch {
+	case m.HTML != "" && m.Text != "":
+		mw := multipart.NewWriter(&buf)
+		header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+		buf.WriteString("\r\n")
+		for _, part := range []struct{ contentType, body string }{
+			{"text/plain; charset=UTF-8", m.Text},
+			{"text/html; charset=UTF-8", m.HTML},
+		} {
+			w, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+			if err != nil {
+				return nil, err
+			}
+			if _, err := w.Write([]byte(part.body)); err != nil {
+				return nil, err
+			}
+		}
+		if err := mw.Close(); err != nil {
+			return nil, err
+		}
+	case m.HTML != "":
+		header("Content-Type", "text/html; charset=UTF-8")
+		buf.WriteString("\r\n" + m.HTML)
+	default:
+		header("Content-Type", "text/plain; charset=UTF-8")
+		buf.WriteString("\r\n" + m.Text)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,34 @@
+package mailer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMessageBytes_RejectsLineBreaks(t *testing.T) {
+	for _, m := range []Message{
+		{From: "site@example.com", To: []string{"a@mail.com"}, Subject: "New message from x\r\nBcc: victim@mail.com", Text: "hi"},
+		{From: "site@example.com", To: []string{"a@mail.com"}, Subject: "Hi\n\nforged body", Text: "hi"},
+		{From: "site@example.com", To: []string{"a@mail.com"}, ReplyTo: "a@mail.com\r\nBcc: victim@mail.com", Text: "hi"},
+	} {
+		if _, err := m.Bytes(); !errors.Is(err, ErrHeaderLineBreak) {
+			t.Errorf("expected ErrHeaderLineBreak for %q, got %v", m.Subject+m.ReplyTo, err)
+		}
+	}
+}
+
+func TestMessageBytes_EncodesSubject(t *testing.T) {
+	b, err := Message{From: "site@example.com", To: []string{"a@mail.com"}, Subject: "Pesan dari Zoë", Text: "hi"}.Bytes()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(string(b), "Subject: =?utf-8?q?Pesan_dari_Zo=C3=AB?=\r\n") {
+		t.Errorf("expected an encoded subject, got %q", b)
+	}
+
+	b, _ = Message{From: "site@example.com", To: []string{"a@mail.com"}, Subject: "Hello", Text: "hi"}.Bytes()
+	if !strings.Contains(string(b), "Subject: Hello\r\n") {
+		t.Errorf("expected an ASCII subject unchanged, got %q", b)
+	}
+}
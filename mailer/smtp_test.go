@@ -0,0 +1,91 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeRelay accepts one SMTP session on a local port. A silent relay
+// accepts the connection but never answers.
+func fakeRelay(t *testing.T, silent bool) (*SMTPMailer, <-chan string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	received := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if silent {
+			time.Sleep(time.Second)
+			return
+		}
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		reply("220 relay ready")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.Fields(line)[0]); cmd {
+			case "EHLO", "HELO", "MAIL", "RCPT":
+				reply("250 OK")
+			case "DATA":
+				reply("354 go ahead")
+				var body strings.Builder
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+					body.WriteString(line)
+				}
+				received <- body.String()
+				reply("250 queued")
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return NewSMTPMailer(host, p, "", ""), received
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	m, received := fakeRelay(t, false)
+	err := m.Send(context.Background(), Message{From: "site@example.com", To: []string{"a@mail.com"}, Subject: "Hello", Text: "plain body"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if body := <-received; !strings.Contains(body, "Subject: Hello") || !strings.Contains(body, "plain body") {
+		t.Errorf("unexpected message %q", body)
+	}
+}
+
+func TestSMTPMailer_Send_Timeout(t *testing.T) {
+	m, _ := fakeRelay(t, true)
+	m.Timeout = 50 * time.Millisecond
+	start := time.Now()
+	err := m.Send(context.Background(), Message{From: "site@example.com", To: []string{"a@mail.com"}, Subject: "Hello", Text: "hi"})
+	if err == nil {
+		t.Fatal("expected a silent relay to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected Send to give up after the timeout, took %v", elapsed)
+	}
+}
@@ -0,0 +1,30 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var (
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt.tmpl"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html.tmpl"))
+)
+
+// Render executes the text and HTML variants of the named template, e.g.
+// "contact_notification" renders contact_notification.txt.tmpl and
+// contact_notification.html.tmpl.
+func Render(name string, data any) (text, html string, err error) {
+	var tb, hb bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&tb, name+".txt.tmpl", data); err != nil {
+		return "", "", err
+	}
+	if err := htmlTemplates.ExecuteTemplate(&hb, name+".html.tmpl", data); err != nil {
+		return "", "", err
+	}
+	return tb.String(), hb.String(), nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"porto/openapi"
+)
+
+// TestOpenAPICoversRoutes fails when an /api route is registered without
+// being documented in openapi.Routes, or documented without existing.
+func TestOpenAPICoversRoutes(t *testing.T) {
+	r := newRouter(handlers{}, "", "")
+	registered := map[string]bool{}
+	err := chi.Walk(r, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if !strings.HasPrefix(route, "/api/") || route == "/api/openapi.json" || route == "/api/docs" {
+			return nil
+		}
+		registered[method+" "+route] = true
+		if !openapi.Has(openapi.Routes, method, route) {
+			t.Errorf("%s %s is not documented in openapi.Routes", method, route)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, op := range openapi.Routes {
+		if !registered[op.Method+" "+op.Path] {
+			t.Errorf("%s %s is documented but not registered", op.Method, op.Path)
+		}
+	}
+}
+
+// TestAdminRoutesRequireToken fails when a route documented as admin-only
+// is reachable without the admin token.
+func TestAdminRoutesRequireToken(t *testing.T) {
+	r := newRouter(handlers{}, "", "secret")
+	params := strings.NewReplacer("{id}", "1", "{slug}", "shop")
+	for _, op := range openapi.Routes {
+		if !op.Admin {
+			continue
+		}
+		for _, header := range []string{"", "Bearer wrong"} {
+			req := httptest.NewRequest(op.Method, params.Replace(op.Path), nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("%s %s with %q: expected 401, got %d", op.Method, op.Path, header, w.Code)
+			}
+		}
+	}
+}
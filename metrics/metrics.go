@@ -0,0 +1,96 @@
+// Package metrics defines the Prometheus metrics the server exposes on
+// /metrics and the helpers that record them.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by method and route pattern.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Repository call latency by repository and operation.",
+		Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"repository", "operation"})
+
+	// ContactsReceived counts contact messages by the status they were
+	// stored with, so spam shows up separately.
+	ContactsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "contacts_received_total",
+		Help: "Contact messages received by stored status.",
+	}, []string{"status"})
+
+	ProjectsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "projects_created_total",
+		Help: "Portfolio projects created.",
+	})
+
+	TestimonialsSubmitted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "testimonials_submitted_total",
+		Help: "Testimonials submitted for moderation.",
+	})
+
+	NewsletterSubscriptions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "newsletter_subscriptions_total",
+		Help: "Newsletter subscription changes by resulting status.",
+	}, []string{"status"})
+)
+
+// ObserveQuery records how long a repository call took. Use it as
+// defer metrics.ObserveQuery("portfolio", "GetAll", time.Now()).
+func ObserveQuery(repository, operation string, start time.Time) {
+	queryDuration.WithLabelValues(repository, operation).Observe(time.Since(start).Seconds())
+}
+
+// Middleware records request counts and latency per chi route pattern.
+// Requests that match no route are labelled "unmatched" to keep the label
+// set bounded.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		route := "unmatched"
+		if rc := chi.RouteContext(r.Context()); rc != nil && rc.RoutePattern() != "" {
+			route = rc.RoutePattern()
+		}
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(rec.status)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddleware(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Middleware)
+	r.Get("/api/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for _, path := range []string{"/api/projects/1", "/api/projects/2", "/nope"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	if got := testutil.ToFloat64(httpRequests.WithLabelValues("GET", "/api/projects/{id}", "404")); got != 2 {
+		t.Errorf("expected 2 requests for the route pattern, got %v", got)
+	}
+	if got := testutil.ToFloat64(httpRequests.WithLabelValues("GET", "unmatched", "404")); got != 1 {
+		t.Errorf("expected 1 unmatched request, got %v", got)
+	}
+	if n := testutil.CollectAndCount(httpDuration); n != 2 {
+		t.Errorf("expected 2 latency series, got %d", n)
+	}
+}
+
+func TestObserveQuery(t *testing.T) {
+	ObserveQuery("portfolio", "GetAll", time.Now())
+	if n := testutil.CollectAndCount(queryDuration, "db_query_duration_seconds"); n != 1 {
+		t.Errorf("expected 1 query series, got %d", n)
+	}
+}
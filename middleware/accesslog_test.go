@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"porto/logging"
+)
+
+func TestRequestIDAndAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logging.New(&buf, slog.LevelInfo))
+
+	var seen string
+	h := RequestID(AccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = logging.RequestID(r.Context())
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte("hi"))
+	})))
+
+	r := httptest.NewRequest(http.MethodGet, "/about?token=secret", nil)
+	r.Header.Set("X-Request-ID", "abc-123")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if seen != "abc-123" || w.Header().Get("X-Request-ID") != "abc-123" {
+		t.Errorf("expected incoming request ID to be kept, got %q / %q", seen, w.Header().Get("X-Request-ID"))
+	}
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("expected one JSON record, got %q", buf.String())
+	}
+	if rec["request_id"] != "abc-123" || rec["path"] != "/about" || rec["status"] != float64(418) || rec["bytes"] != float64(2) {
+		t.Errorf("unexpected access log %v", rec)
+	}
+
+	// malformed IDs are replaced
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Request-ID", "bad id\n")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if id := w.Header().Get("X-Request-ID"); id == "" || id == "bad id\n" {
+		t.Errorf("expected a generated request ID, got %q", id)
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAdmin(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	for _, tc := range []struct {
+		token, header string
+		want          int
+	}{
+		{"secret", "Bearer secret", http.StatusOK},
+		{"secret", "", http.StatusUnauthorized},
+		{"secret", "Bearer wrong", http.StatusUnauthorized},
+		{"secret", "secret", http.StatusUnauthorized},
+		// without a configured token nobody is admin
+		{"", "Bearer ", http.StatusUnauthorized},
+	} {
+		r := httptest.NewRequest(http.MethodPut, "/api/profile", nil)
+		if tc.header != "" {
+			r.Header.Set("Authorization", tc.header)
+		}
+		w := httptest.NewRecorder()
+		Admin(tc.token)(ok).ServeHTTP(w, r)
+		if w.Code != tc.want {
+			t.Errorf("token %q, header %q: expected %d, got %d", tc.token, tc.header, tc.want, w.Code)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestCSRF(t *testing.T) {
+	var seen string
+	h := CSRF(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = CSRFToken(r)
+	}))
+
+	// GET issues a token
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/contact", nil))
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Value != seen || seen == "" {
+		t.Fatalf("expected token cookie matching the request token, got %v and %q", cookies, seen)
+	}
+	cookie := cookies[0]
+
+	post := func(token string) int {
+		form := url.Values{CSRFField: {token}}
+		r := httptest.NewRequest(http.MethodPost, "/contact", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.AddCookie(cookie)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+	if code := post(cookie.Value); code != http.StatusOK {
+		t.Errorf("expected matching token to pass, got %d", code)
+	}
+	if code := post("wrong"); code != http.StatusForbidden {
+		t.Errorf("expected wrong token to be rejected, got %d", code)
+	}
+
+	// header works for scripts
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/subscribe", nil)
+	r.AddCookie(cookie)
+	r.Header.Set("X-CSRF-Token", cookie.Value)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected header token to pass, got %d", w.Code)
+	}
+
+	// no cookie at all
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/contact", nil))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected post without cookie to be rejected, got %d", w.Code)
+	}
+}
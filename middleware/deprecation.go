@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Deprecation describes a deprecated group of routes.
+type Deprecation struct {
+	// Since is when the routes were deprecated (RFC 9745).
+	Since time.Time
+	// Sunset is when they will be removed (RFC 8594).
+	Sunset time.Time
+	// Prefix and Successor map a deprecated path to its replacement for the
+	// Link header, e.g. "/api" and "/api/v1".
+	Prefix, Successor string
+}
+
+// Deprecated sets the Deprecation, Sunset and successor Link headers on
+// every response.
+func Deprecated(d Deprecation) func(http.Handler) http.Handler {
+	deprecation := "@" + strconv.FormatInt(d.Since.Unix(), 10)
+	sunset := d.Sunset.UTC().Format(http.TimeFormat)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			w.Header().Set("Sunset", sunset)
+			if d.Successor != "" {
+				if rest, ok := strings.CutPrefix(r.URL.Path, d.Prefix); ok {
+					w.Header().Add("Link", "<"+d.Successor+rest+`>; rel="successor-version"`)
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeprecated(t *testing.T) {
+	h := Deprecated(Deprecation{
+		Since:     time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+		Sunset:    time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC),
+		Prefix:    "/api",
+		Successor: "/api/v1",
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/projects", nil))
+	if got := w.Header().Get("Deprecation"); got != "@1790812800" {
+		t.Errorf("unexpected Deprecation header %q", got)
+	}
+	if got := w.Header().Get("Sunset"); got != "Thu, 01 Apr 2027 00:00:00 GMT" {
+		t.Errorf("unexpected Sunset header %q", got)
+	}
+	if got := w.Header().Get("Link"); got != `</api/v1/projects>; rel="successor-version"` {
+		t.Errorf("unexpected Link header %q", got)
+	}
+}
@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Policy is a token bucket: Burst requests at once, refilled at Rate per
+// second.
+type Policy struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute allows n requests per minute with bursts of up to n.
+func PerMinute(n int) Policy {
+	return Policy{Rate: float64(n) / 60, Burst: n}
+}
+
+// Decision is the outcome of taking a token.
+type Decision struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long until the next token is available.
+	RetryAfter time.Duration
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+}
+
+// RateLimitStore keeps the buckets. MemoryStore suits a single instance;
+// shared backends such as Redis can implement the same interface.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, p Policy) (Decision, error)
+}
+
+// KeyFunc identifies who a request is counted against.
+type KeyFunc func(r *http.Request) string
+
+// KeyByIP counts requests per client IP. Put RealIP in front of the
+// limiter when running behind a proxy.
+func KeyByIP(r *http.Request) string {
+	return "ip:" + clientAddr(r)
+}
+
+// KeyByUser counts requests per user when user returns an id and falls
+// back to the client IP otherwise.
+func KeyByUser(user func(r *http.Request) string) KeyFunc {
+	return func(r *http.Request) string {
+		if id := user(r); id != "" {
+			return "user:" + id
+		}
+		return KeyByIP(r)
+	}
+}
+
+// RateLimit throttles requests with policy p. The name separates the
+// buckets of different route groups sharing a store. Store errors let the
+// request through.
+func RateLimit(store RateLimitStore, name string, p Policy, key KeyFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d, err := store.Take(r.Context(), name+":"+key(r), p)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "Store error", "component", "RateLimit", "policy", name, "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			h := w.Header()
+			h.Set("X-RateLimit-Limit", strconv.Itoa(p.Burst))
+			h.Set("X-RateLimit-Remaining", strconv.Itoa(d.Remaining))
+			h.Set("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(d.Reset)))
+			if !d.Allowed {
+				h.Set("Retry-After", strconv.Itoa(ceilSeconds(d.RetryAfter)))
+				http.Error(w, "Too many requests, please slow down.", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// maxBuckets bounds memory before full (idle) buckets are dropped.
+const maxBuckets = 10000
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	policy Policy
+}
+
+// MemoryStore keeps token buckets in process memory.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*bucket{}, now: time.Now}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, p Policy) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	burst := float64(p.Burst)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		if len(s.buckets) >= maxBuckets {
+			s.prune(now)
+		}
+		b = &bucket{tokens: burst, last: now, policy: p}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*p.Rate)
+	b.last = now
+
+	d := Decision{Allowed: b.tokens >= 1}
+	if d.Allowed {
+		b.tokens--
+	} else {
+		d.RetryAfter = seconds((1 - b.tokens) / p.Rate)
+	}
+	d.Remaining = int(b.tokens)
+	d.Reset = seconds((burst - b.tokens) / p.Rate)
+	return d, nil
+}
+
+// prune drops buckets that have refilled completely, since a new bucket
+// would start in the same state.
+func (s *MemoryStore) prune(now time.Time) {
+	for key, b := range s.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*b.policy.Rate >= float64(b.policy.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
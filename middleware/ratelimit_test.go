@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	p := PerMinute(2)
+
+	for i := 0; i < 2; i++ {
+		if d, _ := s.Take(context.Background(), "k", p); !d.Allowed {
+			t.Fatalf("request %d: expected to be allowed", i)
+		}
+	}
+	d, _ := s.Take(context.Background(), "k", p)
+	if d.Allowed || d.RetryAfter != 30*time.Second {
+		t.Errorf("expected to wait 30s, got %+v", d)
+	}
+	if d, _ := s.Take(context.Background(), "other", p); !d.Allowed {
+		t.Error("expected keys to have separate buckets")
+	}
+
+	now = now.Add(30 * time.Second)
+	if d, _ := s.Take(context.Background(), "k", p); !d.Allowed || d.Remaining != 0 {
+		t.Errorf("expected one token after refilling, got %+v", d)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	h := RateLimit(NewMemoryStore(), "test", PerMinute(1), KeyByIP)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	req := func(addr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/api/contacts", nil)
+		r.RemoteAddr = addr
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+	w := req("1.2.3.4:1000")
+	if w.Code != http.StatusOK || w.Header().Get("X-RateLimit-Limit") != "1" || w.Header().Get("X-RateLimit-Remaining") != "0" {
+		t.Errorf("expected first request with headers, got %d %v", w.Code, w.Header())
+	}
+	w = req("1.2.3.4:2000")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "60" {
+		t.Errorf("expected 429 with Retry-After 60, got %d %q", w.Code, w.Header().Get("Retry-After"))
+	}
+	if w := req("5.6.7.8:1000"); w.Code != http.StatusOK {
+		t.Errorf("expected another IP to pass, got %d", w.Code)
+	}
+}
+
+func TestRealIP(t *testing.T) {
+	trusted := ParseTrustedProxies("10.0.0.0/8, 192.168.1.1, bogus")
+	if len(trusted) != 2 {
+		t.Fatalf("expected 2 trusted prefixes, got %v", trusted)
+	}
+	var got string
+	h := RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.RemoteAddr
+	}))
+
+	cases := []struct {
+		name, remote, xff, want string
+	}{
+		{"direct client ignores header", "1.2.3.4:1000", "9.9.9.9", "1.2.3.4:1000"},
+		{"trusted proxy", "10.0.0.1:1000", "9.9.9.9", "9.9.9.9"},
+		{"spoofed hop before client", "10.0.0.1:1000", "6.6.6.6, 9.9.9.9, 192.168.1.1", "9.9.9.9"},
+		{"only proxies", "10.0.0.1:1000", "10.0.0.2", "10.0.0.1:1000"},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = c.remote
+		r.Header.Set("X-Forwarded-For", c.xff)
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		if got != c.want {
+			t.Errorf("%s: expected %q, got %q", c.name, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS portfolios (
+    id          SERIAL PRIMARY KEY,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL,
+    image_url   TEXT NOT NULL DEFAULT '',
+    link        TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS experiences (
+    id          SERIAL PRIMARY KEY,
+    title       TEXT NOT NULL,
+    company     TEXT NOT NULL,
+    start_date  TEXT NOT NULL,
+    end_date    TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS contacts (
+    id      SERIAL PRIMARY KEY,
+    name    TEXT NOT NULL,
+    email   TEXT NOT NULL,
+    message TEXT NOT NULL
+);
@@ -0,0 +1,30 @@
+-- Free-text start/end dates become real DATE columns. Existing values are
+-- expected to be ISO dates (YYYY-MM-DD), year-month (YYYY-MM) or a bare year
+-- (YYYY), which becomes the first of the month or of January. Blank end dates
+-- and words for "present" become NULL and mark the position as current.
+ALTER TABLE experiences ADD COLUMN is_current BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE experiences SET is_current = TRUE
+WHERE LOWER(TRIM(end_date)) IN ('', 'present', 'current', 'now', 'ongoing', 'sekarang', 'saat ini', 'kini');
+
+ALTER TABLE experiences
+    ALTER COLUMN start_date TYPE DATE USING (
+        CASE
+            WHEN TRIM(start_date) ~ '^\d{4}$' THEN TRIM(start_date) || '-01-01'
+            WHEN TRIM(start_date) ~ '^\d{4}-\d{2}$' THEN TRIM(start_date) || '-01'
+            ELSE TRIM(start_date)
+        END
+    )::DATE,
+    ALTER COLUMN end_date DROP DEFAULT,
+    ALTER COLUMN end_date DROP NOT NULL,
+    ALTER COLUMN end_date TYPE DATE USING (
+        CASE
+            WHEN is_current THEN NULL
+            WHEN TRIM(end_date) ~ '^\d{4}$' THEN TRIM(end_date) || '-01-01'
+            WHEN TRIM(end_date) ~ '^\d{4}-\d{2}$' THEN TRIM(end_date) || '-01'
+            ELSE TRIM(end_date)
+        END
+    )::DATE;
+
+ALTER TABLE experiences ADD CONSTRAINT experiences_dates_ordered
+    CHECK (end_date IS NULL OR end_date >= start_date);
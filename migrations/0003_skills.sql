@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS skills (
+    id          SERIAL PRIMARY KEY,
+    name        TEXT NOT NULL UNIQUE,
+    category    TEXT NOT NULL DEFAULT '',
+    proficiency TEXT NOT NULL,
+    years       INTEGER NOT NULL DEFAULT 0 CHECK (years >= 0)
+);
+
+CREATE TABLE IF NOT EXISTS portfolio_skills (
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios (id) ON DELETE CASCADE,
+    skill_id     INTEGER NOT NULL REFERENCES skills (id) ON DELETE CASCADE,
+    PRIMARY KEY (portfolio_id, skill_id)
+);
+
+CREATE TABLE IF NOT EXISTS experience_skills (
+    experience_id INTEGER NOT NULL REFERENCES experiences (id) ON DELETE CASCADE,
+    skill_id      INTEGER NOT NULL REFERENCES skills (id) ON DELETE CASCADE,
+    PRIMARY KEY (experience_id, skill_id)
+);
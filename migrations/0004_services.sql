@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS services (
+    id          SERIAL PRIMARY KEY,
+    title       TEXT NOT NULL,
+    icon        TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    sort_order  INTEGER NOT NULL DEFAULT 0
+);
+
+-- Seed with the offerings previously hard-coded in services.html and index.html.
+INSERT INTO services (title, icon, description, sort_order) VALUES
+    ('Wp developing', 'img/services/s1.png', 'Creeping for female light years that lesser can''t evening heaven isn''t bearing tree', 1),
+    ('UI/ux design', 'img/services/s2.png', 'Creeping for female light years that lesser can''t evening heaven isn''t bearing tree', 2),
+    ('Web design', 'img/services/s3.png', 'Creeping for female light years that lesser can''t evening heaven isn''t bearing tree', 3),
+    ('seo optimize', 'img/services/s4.png', 'Creeping for female light years that lesser can''t evening heaven isn''t bearing tree', 4);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS testimonials (
+    id         SERIAL PRIMARY KEY,
+    author     TEXT NOT NULL,
+    role       TEXT NOT NULL DEFAULT '',
+    company    TEXT NOT NULL DEFAULT '',
+    avatar_url TEXT NOT NULL DEFAULT '',
+    quote      TEXT NOT NULL,
+    rating     INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    status     TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS testimonials_status_idx ON testimonials (status, created_at DESC);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS media (
+    id           SERIAL PRIMARY KEY,
+    file_name    TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size         BIGINT NOT NULL,
+    url          TEXT NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS clients (
+    id            SERIAL PRIMARY KEY,
+    name          TEXT NOT NULL,
+    website       TEXT NOT NULL DEFAULT '',
+    logo_media_id INTEGER NOT NULL REFERENCES media (id),
+    sort_order    INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS client_portfolios (
+    client_id    INTEGER NOT NULL REFERENCES clients (id) ON DELETE CASCADE,
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios (id) ON DELETE CASCADE,
+    PRIMARY KEY (client_id, portfolio_id)
+);
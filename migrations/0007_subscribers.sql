@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS subscribers (
+    id           SERIAL PRIMARY KEY,
+    email        TEXT NOT NULL UNIQUE,
+    token        TEXT NOT NULL UNIQUE,
+    status       TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'unsubscribed')),
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    confirmed_at TIMESTAMPTZ
+);
@@ -0,0 +1,7 @@
+ALTER TABLE contacts
+    ADD COLUMN IF NOT EXISTS subject    TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS status     TEXT NOT NULL DEFAULT 'new' CHECK (status IN ('new', 'read', 'replied', 'archived', 'spam')),
+    ADD COLUMN IF NOT EXISTS notes      TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS contacts_status_idx ON contacts (status, created_at DESC);
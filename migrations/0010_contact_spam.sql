@@ -0,0 +1,3 @@
+ALTER TABLE contacts
+    ADD COLUMN IF NOT EXISTS spam_score   INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS spam_reasons TEXT NOT NULL DEFAULT '';
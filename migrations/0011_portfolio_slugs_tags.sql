@@ -0,0 +1,13 @@
+ALTER TABLE portfolios
+    ADD COLUMN IF NOT EXISTS slug       TEXT,
+    ADD COLUMN IF NOT EXISTS tags       TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Existing projects get a slug from their name; the id keeps it unique.
+UPDATE portfolios
+SET slug = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')) || '-' || id
+WHERE slug IS NULL;
+
+ALTER TABLE portfolios ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS portfolios_slug_key ON portfolios (slug);
@@ -0,0 +1,3 @@
+-- Translated text fields keyed by locale, e.g. {"id": {"name": "..."}}.
+ALTER TABLE portfolios ADD COLUMN IF NOT EXISTS translations JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE experiences ADD COLUMN IF NOT EXISTS translations JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,25 @@
+-- The site owner. The boolean key allows a single row.
+CREATE TABLE IF NOT EXISTS profile (
+    id           BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    name         TEXT NOT NULL,
+    headline     TEXT NOT NULL DEFAULT '',
+    bio          TEXT NOT NULL DEFAULT '',
+    avatar_url   TEXT NOT NULL DEFAULT '',
+    location     TEXT NOT NULL DEFAULT '',
+    phone        TEXT NOT NULL DEFAULT '',
+    email        TEXT NOT NULL DEFAULT '',
+    links        JSONB NOT NULL DEFAULT '[]',
+    availability TEXT NOT NULL DEFAULT 'available' CHECK (availability IN ('available', 'busy', 'unavailable')),
+    translations JSONB NOT NULL DEFAULT '{}',
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Start from what the about page used to hard-code.
+INSERT INTO profile (name, bio, avatar_url, translations)
+VALUES (
+    'Fauzan Alsya Prasetyo',
+    'I am a software engineer focused on building web applications with clean architecture and Go.',
+    'img/about-us.png',
+    '{"id": {"bio": "Saya adalah seorang software engineer yang berfokus pada pengembangan aplikasi web dengan arsitektur clean architecture dan Go."}}'
+)
+ON CONFLICT (id) DO NOTHING;
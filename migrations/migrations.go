@@ -0,0 +1,138 @@
+// Package migrations embeds the SQL schema migrations and applies them in
+// file name order, recording each one in the schema_migrations table.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    TEXT PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)`
+
+// Versions lists the embedded migrations, e.g. "0001_init", in order.
+func Versions() []string {
+	names, _ := fs.Glob(files, "*.sql")
+	sort.Strings(names)
+	versions := make([]string, len(names))
+	for i, name := range names {
+		versions[i] = strings.TrimSuffix(name, ".sql")
+	}
+	return versions
+}
+
+// Pending returns the migrations that have not been applied yet. It only
+// reads, so readiness probes can call it; without a schema_migrations table
+// every migration is pending.
+func Pending(ctx context.Context, db *sql.DB) ([]string, error) {
+	var exists bool
+	if err := db.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return Versions(), nil
+	}
+	rows, err := db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := make(map[string]bool)
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		applied[v] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	var pending []string
+	for _, v := range Versions() {
+		if !applied[v] {
+			pending = append(pending, v)
+		}
+	}
+	return pending, nil
+}
+
+// Apply runs every pending migration, each in its own transaction, and
+// stops at the first failure.
+func Apply(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, createTable); err != nil {
+		return err
+	}
+	pending, err := Pending(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, v := range pending {
+		if err := apply(ctx, db, v); err != nil {
+			slog.ErrorContext(ctx, "Migration failed", "component", "migrations", "version", v, "error", err)
+			return err
+		}
+		slog.InfoContext(ctx, "Applied migration", "component", "migrations", "version", v)
+	}
+	return nil
+}
+
+// Baseline records every migration up to and including version as applied
+// without running it. It adopts a database whose schema was migrated by hand
+// before schema_migrations existed; recording a version twice is harmless.
+func Baseline(ctx context.Context, db *sql.DB, version string) error {
+	versions := Versions()
+	n := slices.Index(versions, version)
+	if n < 0 {
+		return fmt.Errorf("unknown migration %q", version)
+	}
+	if _, err := db.ExecContext(ctx, createTable); err != nil {
+		return err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, v := range versions[:n+1] {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES ($1) ON CONFLICT (version) DO NOTHING", v); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "Recorded migration baseline", "component", "migrations", "version", version)
+	return nil
+}
+
+func apply(ctx context.Context, db *sql.DB, version string) error {
+	body, err := files.ReadFile(version + ".sql")
+	if err != nil {
+		return err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, string(body)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
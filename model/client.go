@@ -0,0 +1,12 @@
+package model
+
+// Client is a brand shown in the logo strip on the home page.
+type Client struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Website      string `json:"website"`
+	LogoMediaID  int    `json:"logo_media_id"`
+	LogoURL      string `json:"logo_url"`
+	SortOrder    int    `json:"order"`
+	PortfolioIDs []int  `json:"portfolio_ids"`
+}
@@ -1,8 +1,35 @@
 package model
 
+import "time"
+
+const (
+	ContactNew      = "new"
+	ContactRead     = "read"
+	ContactReplied  = "replied"
+	ContactArchived = "archived"
+	ContactSpam     = "spam"
+)
+
+// ContactStatuses lists the inbox states in workflow order.
+var ContactStatuses = []string{ContactNew, ContactRead, ContactReplied, ContactArchived, ContactSpam}
+
 type Contact struct {
-	ID      int    `json:"id"`
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Message string `json:"message"`
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Subject   string    `json:"subject"`
+	Message   string    `json:"message"`
+	Status    string    `json:"status"`
+	Notes     string    `json:"notes"`
+	CreatedAt time.Time `json:"created_at"`
+	// SpamScore and SpamReasons record why the spam checks flagged it.
+	SpamScore   int    `json:"spam_score"`
+	SpamReasons string `json:"spam_reasons,omitempty"`
+}
+
+// ContactCounts summarises the inbox for the admin UI. Unread is the
+// number of contacts that are still new.
+type ContactCounts struct {
+	Unread   int            `json:"unread"`
+	ByStatus map[string]int `json:"by_status"`
 }
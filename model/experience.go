@@ -4,7 +4,24 @@ type Experience struct {
 	ID          int    `json:"id"`
 	Title       string `json:"title"`
 	Company     string `json:"company"`
-	StartDate   string `json:"start_date"`
-	EndDate     string `json:"end_date"`
+	StartDate   Date   `json:"start_date"`
+	EndDate     Date   `json:"end_date"`
+	IsCurrent   bool   `json:"is_current"`
 	Description string `json:"description"`
+	// Tenure is computed by the service, e.g. "2 yrs 3 mos"; it is not stored.
+	Tenure string `json:"tenure,omitempty"`
+	// Translations override Title and Description in other locales.
+	Translations Translations[ExperienceText] `json:"translations,omitempty"`
+}
+
+// Localized returns e with the text of its translation into locale, if any.
+func (e Experience) Localized(locale string) Experience {
+	t := e.Translations[locale]
+	if t.Title != "" {
+		e.Title = t.Title
+	}
+	if t.Description != "" {
+		e.Description = t.Description
+	}
+	return e
 }
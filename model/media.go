@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// Media is an uploaded file, such as a client logo, served from URL.
+type Media struct {
+	ID          int       `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -1,9 +1,30 @@
 package model
 
+import "time"
+
 type Portfolio struct {
 	ID          int    `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	ImageURL    string `json:"image_url"`
 	Link        string `json:"link"`
+	// Slug identifies the project in URLs, e.g. "company-website".
+	Slug      string    `json:"slug"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Translations override Name and Description in other locales.
+	Translations Translations[PortfolioText] `json:"translations,omitempty"`
+}
+
+// Localized returns p with the text of its translation into locale, if any.
+func (p Portfolio) Localized(locale string) Portfolio {
+	t := p.Translations[locale]
+	if t.Name != "" {
+		p.Name = t.Name
+	}
+	if t.Description != "" {
+		p.Description = t.Description
+	}
+	return p
 }
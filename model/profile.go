@@ -0,0 +1,84 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	AvailabilityAvailable   = "available"
+	AvailabilityBusy        = "busy"
+	AvailabilityUnavailable = "unavailable"
+)
+
+// AvailabilityStatuses lists whether the owner takes on new work, from most
+// to least available.
+var AvailabilityStatuses = []string{AvailabilityAvailable, AvailabilityBusy, AvailabilityUnavailable}
+
+// Profile is the site owner shown on every page. There is exactly one.
+type Profile struct {
+	Name      string      `json:"name"`
+	Headline  string      `json:"headline"`
+	Bio       string      `json:"bio"`
+	AvatarURL string      `json:"avatar_url"`
+	Location  string      `json:"location"`
+	Phone     string      `json:"phone"`
+	Email     string      `json:"email"`
+	Links     SocialLinks `json:"links"`
+	// Availability is one of AvailabilityStatuses.
+	Availability string    `json:"availability"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	// Translations override Headline and Bio in other locales.
+	Translations Translations[ProfileText] `json:"translations,omitempty"`
+}
+
+// Localized returns p with the text of its translation into locale, if any.
+func (p Profile) Localized(locale string) Profile {
+	t := p.Translations[locale]
+	if t.Headline != "" {
+		p.Headline = t.Headline
+	}
+	if t.Bio != "" {
+		p.Bio = t.Bio
+	}
+	return p
+}
+
+// ProfileText holds the translatable fields of the profile. Empty fields
+// fall back to the untranslated text.
+type ProfileText struct {
+	Headline string `json:"headline,omitempty"`
+	Bio      string `json:"bio,omitempty"`
+}
+
+// SocialLink is a profile elsewhere. Network names the icon, e.g. "github".
+type SocialLink struct {
+	Network string `json:"network"`
+	URL     string `json:"url"`
+}
+
+// SocialLinks maps to a JSONB array.
+type SocialLinks []SocialLink
+
+func (l *SocialLinks) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("cannot scan %T into model.SocialLinks", src)
+	}
+}
+
+func (l SocialLinks) Value() (driver.Value, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]SocialLink(l))
+}
@@ -0,0 +1,9 @@
+package model
+
+// ResumeImport reports what an imported résumé changed.
+type ResumeImport struct {
+	ExperiencesCreated int `json:"experiences_created"`
+	ExperiencesUpdated int `json:"experiences_updated"`
+	ProjectsCreated    int `json:"projects_created"`
+	ProjectsUpdated    int `json:"projects_updated"`
+}
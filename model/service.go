@@ -0,0 +1,10 @@
+package model
+
+// Service is an offering listed on the services page, e.g. "Web design".
+type Service struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Icon        string `json:"icon"`
+	Description string `json:"description"`
+	SortOrder   int    `json:"order"`
+}
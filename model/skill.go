@@ -0,0 +1,34 @@
+package model
+
+const (
+	ProficiencyBeginner     = "beginner"
+	ProficiencyIntermediate = "intermediate"
+	ProficiencyAdvanced     = "advanced"
+	ProficiencyExpert       = "expert"
+)
+
+// ProficiencyLevels lists the accepted proficiency values from lowest to highest.
+var ProficiencyLevels = []string{
+	ProficiencyBeginner,
+	ProficiencyIntermediate,
+	ProficiencyAdvanced,
+	ProficiencyExpert,
+}
+
+type Skill struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Category      string `json:"category"`
+	Proficiency   string `json:"proficiency"`
+	Years         int    `json:"years"`
+	PortfolioIDs  []int  `json:"portfolio_ids"`
+	ExperienceIDs []int  `json:"experience_ids"`
+}
+
+// SkillUsage is a skill together with the projects and experiences it was
+// used in, as shown in the skills matrix.
+type SkillUsage struct {
+	Skill
+	Projects    []Portfolio  `json:"projects"`
+	Experiences []Experience `json:"experiences"`
+}
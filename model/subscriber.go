@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+const (
+	SubscriberPending      = "pending"
+	SubscriberConfirmed    = "confirmed"
+	SubscriberUnsubscribed = "unsubscribed"
+)
+
+type Subscriber struct {
+	ID    int    `json:"id"`
+	Email string `json:"email"`
+	// Token identifies the subscriber in confirm and unsubscribe links.
+	Token       string     `json:"-"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+}
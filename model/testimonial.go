@@ -0,0 +1,30 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	TestimonialPending  = "pending"
+	TestimonialApproved = "approved"
+	TestimonialRejected = "rejected"
+)
+
+type Testimonial struct {
+	ID        int       `json:"id"`
+	Author    string    `json:"author"`
+	Role      string    `json:"role"`
+	Company   string    `json:"company"`
+	AvatarURL string    `json:"avatar_url"`
+	Quote     string    `json:"quote"`
+	Rating    int       `json:"rating"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Stars renders the rating out of five, e.g. "★★★★☆".
+func (t Testimonial) Stars() string {
+	rating := min(max(t.Rating, 0), 5)
+	return strings.Repeat("★", rating) + strings.Repeat("☆", 5-rating)
+}
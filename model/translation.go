@@ -0,0 +1,49 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Translations maps a locale, e.g. "id", to the translated text fields of a
+// record. It maps to a JSONB column; nil means "no change" when a record is
+// updated, so clients that do not know about translations keep them.
+type Translations[T any] map[string]T
+
+func (t *Translations[T]) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into model.Translations", src)
+	}
+	return json.Unmarshal(b, t)
+}
+
+func (t Translations[T]) Value() (driver.Value, error) {
+	if t == nil {
+		return nil, nil
+	}
+	return json.Marshal(map[string]T(t))
+}
+
+// PortfolioText holds the translatable fields of a project. Empty fields
+// fall back to the untranslated text.
+type PortfolioText struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ExperienceText holds the translatable fields of an experience. Empty
+// fields fall back to the untranslated text.
+type ExperienceText struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,227 @@
+// Package openapi builds the OpenAPI 3 description of the JSON API from
+// the route table in routes.go, with schemas derived from the model
+// structs, and serves it together with a docs page.
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Operation describes one method on one route.
+type Operation struct {
+	Method  string
+	Path    string
+	Tag     string
+	Summary string
+	// Query lists the query parameters, all of them optional.
+	Query []Param
+	// Body is a value of the JSON request body type, or Upload for a
+	// multipart file upload.
+	Body any
+	// Status is the success status; Response is a value of the body type
+	// returned with it, nil for an empty body.
+	Status      int
+	Response    any
+	ContentType string
+	// Errors lists the error statuses the route returns.
+	Errors []int
+	// Deprecated routes are kept for existing clients and will be removed.
+	Deprecated bool
+	// Admin routes require the admin token as a bearer token.
+	Admin bool
+}
+
+type Param struct {
+	Name        string
+	Description string
+}
+
+// Upload marks a multipart/form-data request with a single "file" part.
+type Upload struct{}
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+var errorDescriptions = map[int]string{
+	http.StatusBadRequest:          "The request is malformed or fails validation; the body explains why.",
+	http.StatusUnauthorized:        "The admin bearer token is missing or wrong.",
+	http.StatusNotFound:            "No resource has the given id or token.",
+	http.StatusTooManyRequests:     "The client is rate limited; see Retry-After.",
+	http.StatusInternalServerError: "The server failed to handle the request.",
+}
+
+// Spec returns the OpenAPI document for ops.
+func Spec(ops []Operation) map[string]any {
+	defs := schemas{}
+	paths := map[string]map[string]any{}
+	for _, op := range ops {
+		if paths[op.Path] == nil {
+			paths[op.Path] = map[string]any{}
+		}
+		paths[op.Path][strings.ToLower(op.Method)] = op.build(defs)
+	}
+
+	responses := map[string]any{}
+	for status, desc := range errorDescriptions {
+		responses[errorName(status)] = map[string]any{
+			"description": desc,
+			"content": map[string]any{
+				"text/plain": map[string]any{"schema": Schema{"type": "string"}},
+			},
+		}
+	}
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Portfolio API",
+			"version": "1.0.0",
+			"description": "Versions are served under /api/v1 and /api/v2; the unversioned /api paths " +
+				"are deprecated aliases of v1. Errors are returned with the status codes listed per operation. " +
+				"Validation errors carry a plain-text message; other errors have an empty body.",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas":   defs,
+			"responses": responses,
+			"securitySchemes": map[string]any{
+				"admin": map[string]any{"type": "http", "scheme": "bearer"},
+			},
+		},
+	}
+}
+
+func (op Operation) build(defs schemas) map[string]any {
+	out := map[string]any{
+		"tags":        []string{op.Tag},
+		"summary":     op.Summary,
+		"operationId": operationID(op),
+	}
+	if op.Deprecated {
+		out["deprecated"] = true
+	}
+	if op.Admin {
+		out["security"] = []map[string][]string{{"admin": {}}}
+	}
+	var params []map[string]any
+	for _, m := range pathParam.FindAllStringSubmatch(op.Path, -1) {
+		schema := Schema{"type": "string"}
+		if m[1] == "id" {
+			schema = Schema{"type": "integer"}
+		}
+		params = append(params, map[string]any{"name": m[1], "in": "path", "required": true, "schema": schema})
+	}
+	for _, q := range op.Query {
+		params = append(params, map[string]any{
+			"name": q.Name, "in": "query", "description": q.Description, "schema": Schema{"type": "string"},
+		})
+	}
+	if params != nil {
+		out["parameters"] = params
+	}
+
+	switch op.Body.(type) {
+	case nil:
+	case Upload:
+		out["requestBody"] = map[string]any{
+			"required": true,
+			"content": map[string]any{"multipart/form-data": map[string]any{"schema": Schema{
+				"type":       "object",
+				"properties": Schema{"file": Schema{"type": "string", "format": "binary"}},
+			}}},
+		}
+	default:
+		out["requestBody"] = map[string]any{
+			"required": true,
+			"content":  map[string]any{"application/json": map[string]any{"schema": defs.of(reflect.TypeOf(op.Body))}},
+		}
+	}
+
+	success := map[string]any{"description": http.StatusText(op.Status)}
+	if op.Response != nil {
+		contentType, schema := op.ContentType, Schema{"type": "string"}
+		if contentType == "" {
+			contentType, schema = "application/json", defs.of(reflect.TypeOf(op.Response))
+		}
+		success["content"] = map[string]any{contentType: map[string]any{"schema": schema}}
+	}
+	responses := map[string]any{strconv.Itoa(op.Status): success}
+	errors := op.Errors
+	if op.Admin {
+		errors = append([]int{http.StatusUnauthorized}, errors...)
+	}
+	for _, status := range errors {
+		responses[strconv.Itoa(status)] = map[string]any{"$ref": "#/components/responses/" + errorName(status)}
+	}
+	out["responses"] = responses
+	return out
+}
+
+// operationID turns "GET /api/contacts/{id}/status" into
+// "getApiContactsIdStatus".
+func operationID(op Operation) string {
+	id := strings.ToLower(op.Method)
+	for _, part := range strings.FieldsFunc(op.Path, func(r rune) bool { return !('a' <= r && r <= 'z' || '0' <= r && r <= '9') }) {
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
+
+func errorName(status int) string {
+	return strings.ReplaceAll(http.StatusText(status), " ", "")
+}
+
+// Has reports whether ops documents method on path.
+func Has(ops []Operation, method, path string) bool {
+	for _, op := range ops {
+		if op.Method == method && op.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// Handler serves the specification as JSON.
+func Handler(ops []Operation) http.Handler {
+	body, err := json.MarshalIndent(Spec(ops), "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+}
+
+// swaggerUI is the exact swagger-ui-dist release the docs page loads. npm
+// never republishes a version, so the files behind it cannot change.
+const swaggerUI = "https://unpkg.com/swagger-ui-dist@5.17.14"
+
+// Docs serves an interactive Swagger UI page for the spec at specURL.
+func Docs(specURL string) http.Handler {
+	page := strings.NewReplacer("{{SPEC_URL}}", specURL, "{{SWAGGER_UI}}", swaggerUI).Replace(docsPage)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(page))
+	})
+}
+
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Portfolio API</title>
+<link rel="stylesheet" href="{{SWAGGER_UI}}/swagger-ui.css" crossorigin="anonymous">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="{{SWAGGER_UI}}/swagger-ui-bundle.js" crossorigin="anonymous"></script>
+<script>
+SwaggerUIBundle({url: "{{SPEC_URL}}", dom_id: "#swagger-ui"});
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,70 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSpec(t *testing.T) {
+	w := httptest.NewRecorder()
+	Handler(Routes).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+	var spec struct {
+		Paths      map[string]map[string]json.RawMessage
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Type   string
+					Format string
+					Enum   []string
+				}
+			}
+		}
+	}
+	if err := json.NewDecoder(w.Body).Decode(&spec); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := spec.Paths["/api/contacts/{id}/status"]["put"]; !ok {
+		t.Error("expected PUT /api/contacts/{id}/status to be documented")
+	}
+	var profile struct {
+		Security  []map[string][]string
+		Responses map[string]json.RawMessage
+	}
+	if err := json.Unmarshal(spec.Paths["/api/v1/profile"]["put"], &profile); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := profile.Responses["401"]; len(profile.Security) != 1 || !ok {
+		t.Errorf("expected PUT /api/v1/profile to require the admin token, got %+v", profile)
+	}
+
+	contact := spec.Components.Schemas["Contact"].Properties
+	if len(contact["status"].Enum) != 5 || contact["created_at"].Format != "date-time" {
+		t.Errorf("unexpected Contact schema %+v", contact)
+	}
+	if spec.Components.Schemas["Experience"].Properties["start_date"].Format != "date" {
+		t.Error("expected model.Date to be documented as a date")
+	}
+	// embedded structs are flattened like encoding/json does
+	sub := spec.Components.Schemas["ContactSubmission"].Properties
+	if sub["email"].Type != "string" || sub["website"].Type != "string" {
+		t.Errorf("unexpected ContactSubmission schema %+v", sub)
+	}
+	if _, ok := spec.Components.Schemas["Subscriber"].Properties["Token"]; ok {
+		t.Error("expected json:\"-\" fields to be skipped")
+	}
+}
+
+func TestDocs_PinsSwaggerUI(t *testing.T) {
+	w := httptest.NewRecorder()
+	Docs("/api/openapi.json").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/docs", nil))
+	body := w.Body.String()
+	if !strings.Contains(body, `src="`+swaggerUI+`/swagger-ui-bundle.js"`) || !strings.Contains(body, `url: "/api/openapi.json"`) {
+		t.Errorf("expected the pinned bundle and the spec URL, got %s", body)
+	}
+	if strings.Contains(body, "swagger-ui-dist@5/") {
+		t.Error("expected an exact swagger-ui-dist version")
+	}
+}
@@ -0,0 +1,164 @@
+package openapi
+
+import (
+	"net/http"
+
+	"porto/dto"
+	"porto/model"
+)
+
+// Request and response bodies that have no model type.
+type (
+	IDList struct {
+		IDs []int `json:"ids"`
+	}
+	StatusUpdate struct {
+		Status string `json:"status"`
+	}
+	BulkStatusUpdate struct {
+		IDs    []int  `json:"ids"`
+		Status string `json:"status"`
+	}
+	NotesUpdate struct {
+		Notes string `json:"notes"`
+	}
+	Updated struct {
+		Updated int `json:"updated"`
+	}
+	ContactSubmission struct {
+		model.Contact
+		// Website is a honeypot and must be left empty.
+		Website string `json:"website"`
+		// FormToken is optional; without it only the rate limits and the
+		// message content are checked.
+		FormToken string `json:"form_token"`
+	}
+	FormToken struct {
+		FormToken string `json:"form_token"`
+	}
+	SubscribeRequest struct {
+		Email string `json:"email"`
+	}
+	Message struct {
+		Message string `json:"message"`
+	}
+)
+
+var (
+	bad      = []int{http.StatusBadRequest}
+	failed   = []int{http.StatusInternalServerError}
+	badID    = []int{http.StatusBadRequest, http.StatusInternalServerError}
+	badQuery = []int{http.StatusBadRequest, http.StatusInternalServerError}
+	notFound = []int{http.StatusBadRequest, http.StatusNotFound}
+	limited  = []int{http.StatusBadRequest, http.StatusTooManyRequests}
+	status   = func(desc string) []Param { return []Param{{"status", desc}} }
+)
+
+// Routes documents every /api route registered in routes.go. A test in
+// package main fails when a route is added without an entry here.
+var Routes = concat(
+	prefixed("/api/v1", v1, false),
+	prefixed("/api/v2", v2, false),
+	prefixed("/api", v1, true),
+	unprefixed,
+)
+
+// v1 is served under /api/v1 and, deprecated, directly under /api.
+var v1 = []Operation{
+	{Method: "GET", Path: "/projects", Tag: "Projects", Summary: "List projects", Status: 200, Response: []dto.ProjectV1{}, Errors: failed},
+	{Method: "POST", Path: "/projects", Tag: "Projects", Summary: "Create a project", Body: dto.ProjectV1{}, Status: 201, Response: dto.ProjectV1{}, Errors: bad},
+	{Method: "PUT", Path: "/projects", Tag: "Projects", Summary: "Update a project", Body: dto.ProjectV1{}, Status: 200, Response: dto.ProjectV1{}, Errors: bad},
+	{Method: "DELETE", Path: "/projects/{id}", Tag: "Projects", Summary: "Delete a project", Status: 204, Errors: badID},
+
+	{Method: "GET", Path: "/experiences", Tag: "Experiences", Summary: "List experiences, current positions first", Status: 200, Response: []dto.ExperienceV1{}, Errors: failed},
+	{Method: "POST", Path: "/experiences", Tag: "Experiences", Summary: "Create an experience", Body: dto.ExperienceV1{}, Status: 201, Response: dto.ExperienceV1{}, Errors: bad},
+	{Method: "PUT", Path: "/experiences", Tag: "Experiences", Summary: "Update an experience", Body: dto.ExperienceV1{}, Status: 200, Response: dto.ExperienceV1{}, Errors: bad},
+	{Method: "DELETE", Path: "/experiences/{id}", Tag: "Experiences", Summary: "Delete an experience", Status: 204, Errors: badID},
+
+	{Method: "GET", Path: "/skills", Tag: "Skills", Summary: "List skills", Status: 200, Response: []model.Skill{}, Errors: failed},
+	{Method: "GET", Path: "/skills/usage", Tag: "Skills", Summary: "Skills with the projects and experiences they were used in", Query: []Param{{"name", "Only skills whose name matches"}}, Status: 200, Response: []model.SkillUsage{}, Errors: failed},
+	{Method: "POST", Path: "/skills", Tag: "Skills", Summary: "Create a skill", Body: model.Skill{}, Status: 201, Response: model.Skill{}, Errors: bad},
+	{Method: "PUT", Path: "/skills", Tag: "Skills", Summary: "Update a skill", Body: model.Skill{}, Status: 200, Response: model.Skill{}, Errors: bad},
+	{Method: "DELETE", Path: "/skills/{id}", Tag: "Skills", Summary: "Delete a skill", Status: 204, Errors: badID},
+
+	{Method: "GET", Path: "/services", Tag: "Services", Summary: "List services in display order", Status: 200, Response: []model.Service{}, Errors: failed},
+	{Method: "POST", Path: "/services", Tag: "Services", Summary: "Create a service", Body: model.Service{}, Status: 201, Response: model.Service{}, Errors: bad},
+	{Method: "PUT", Path: "/services", Tag: "Services", Summary: "Update a service", Body: model.Service{}, Status: 200, Response: model.Service{}, Errors: bad},
+	{Method: "PUT", Path: "/services/order", Tag: "Services", Summary: "Reorder services by id", Body: IDList{}, Status: 204, Errors: bad},
+	{Method: "DELETE", Path: "/services/{id}", Tag: "Services", Summary: "Delete a service", Status: 204, Errors: badID},
+
+	{Method: "GET", Path: "/testimonials", Tag: "Testimonials", Summary: "List approved testimonials", Status: 200, Response: []model.Testimonial{}, Errors: failed},
+	{Method: "POST", Path: "/testimonials", Tag: "Testimonials", Summary: "Submit a testimonial for moderation", Body: model.Testimonial{}, Status: 202, Response: model.Testimonial{}, Errors: limited},
+	{Method: "GET", Path: "/admin/testimonials", Tag: "Testimonials", Summary: "List testimonials for moderation", Query: status("pending, approved or rejected; all when empty"), Status: 200, Response: []model.Testimonial{}, Errors: bad, Admin: true},
+	{Method: "POST", Path: "/admin/testimonials/{id}/approve", Tag: "Testimonials", Summary: "Approve a testimonial", Status: 204, Errors: notFound, Admin: true},
+	{Method: "POST", Path: "/admin/testimonials/{id}/reject", Tag: "Testimonials", Summary: "Reject a testimonial", Status: 204, Errors: notFound, Admin: true},
+	{Method: "DELETE", Path: "/admin/testimonials/{id}", Tag: "Testimonials", Summary: "Delete a testimonial", Status: 204, Errors: badID, Admin: true},
+
+	{Method: "GET", Path: "/media", Tag: "Media", Summary: "List uploaded media", Status: 200, Response: []model.Media{}, Errors: failed},
+	{Method: "POST", Path: "/media", Tag: "Media", Summary: "Upload a file", Body: Upload{}, Status: 201, Response: model.Media{}, Errors: bad},
+	{Method: "DELETE", Path: "/media/{id}", Tag: "Media", Summary: "Delete a file", Status: 204, Errors: badID},
+
+	{Method: "GET", Path: "/clients", Tag: "Clients", Summary: "List clients in display order", Status: 200, Response: []model.Client{}, Errors: failed},
+	{Method: "POST", Path: "/clients", Tag: "Clients", Summary: "Create a client", Body: model.Client{}, Status: 201, Response: model.Client{}, Errors: bad},
+	{Method: "PUT", Path: "/clients", Tag: "Clients", Summary: "Update a client", Body: model.Client{}, Status: 200, Response: model.Client{}, Errors: bad},
+	{Method: "PUT", Path: "/clients/order", Tag: "Clients", Summary: "Reorder clients by id", Body: IDList{}, Status: 204, Errors: bad},
+	{Method: "DELETE", Path: "/clients/{id}", Tag: "Clients", Summary: "Delete a client", Status: 204, Errors: badID},
+
+	{Method: "POST", Path: "/newsletter/subscribe", Tag: "Newsletter", Summary: "Subscribe and send a confirmation e-mail", Body: SubscribeRequest{}, Status: 202, Response: Message{}, Errors: limited},
+	{Method: "GET", Path: "/admin/subscribers", Tag: "Newsletter", Summary: "List subscribers", Query: status("pending, confirmed or unsubscribed; confirmed when empty"), Status: 200, Response: []model.Subscriber{}, Errors: bad, Admin: true},
+	{Method: "GET", Path: "/admin/subscribers/export", Tag: "Newsletter", Summary: "Export confirmed subscribers as CSV", Status: 200, Response: "", ContentType: "text/csv", Errors: failed, Admin: true},
+
+	{Method: "GET", Path: "/admin/jobs", Tag: "Jobs", Summary: "List background jobs", Query: status("queued, running, done or dead; all when empty"), Status: 200, Response: []model.Job{}, Errors: bad, Admin: true},
+	{Method: "POST", Path: "/admin/jobs/{id}/retry", Tag: "Jobs", Summary: "Requeue a dead job", Status: 204, Errors: notFound, Admin: true},
+
+	{Method: "GET", Path: "/profile", Tag: "Profile", Summary: "Get the owner profile shown on every page", Status: 200, Response: model.Profile{}, Errors: failed},
+	{Method: "PUT", Path: "/profile", Tag: "Profile", Summary: "Replace the owner profile", Body: model.Profile{}, Status: 200, Response: model.Profile{}, Errors: bad, Admin: true},
+
+	{Method: "GET", Path: "/contacts", Tag: "Contacts", Summary: "List the inbox", Query: status("Only contacts with this status; every status but spam when empty"), Status: 200, Response: []model.Contact{}, Errors: badQuery, Admin: true},
+	{Method: "GET", Path: "/contacts/counts", Tag: "Contacts", Summary: "Unread count and totals per status", Status: 200, Response: model.ContactCounts{}, Errors: failed, Admin: true},
+	{Method: "GET", Path: "/contacts/form-token", Tag: "Contacts", Summary: "Issue a form token for a submission", Status: 200, Response: FormToken{}},
+	{Method: "POST", Path: "/contacts", Tag: "Contacts", Summary: "Send a message", Body: ContactSubmission{}, Status: 201, Response: model.Contact{}, Errors: limited},
+	{Method: "PUT", Path: "/contacts/status", Tag: "Contacts", Summary: "Set the status of several contacts", Body: BulkStatusUpdate{}, Status: 200, Response: Updated{}, Errors: bad, Admin: true},
+	{Method: "GET", Path: "/contacts/{id}", Tag: "Contacts", Summary: "Get a contact", Status: 200, Response: model.Contact{}, Errors: notFound, Admin: true},
+	{Method: "PUT", Path: "/contacts/{id}/status", Tag: "Contacts", Summary: "Set the status of a contact", Body: StatusUpdate{}, Status: 204, Errors: notFound, Admin: true},
+	{Method: "PUT", Path: "/contacts/{id}/notes", Tag: "Contacts", Summary: "Replace the internal notes of a contact", Body: NotesUpdate{}, Status: 204, Errors: notFound, Admin: true},
+	{Method: "DELETE", Path: "/contacts/{id}", Tag: "Contacts", Summary: "Delete a contact", Status: 204, Errors: badID, Admin: true},
+}
+
+// unprefixed lists the /api routes outside the versioned API.
+var unprefixed = []Operation{
+	{Method: "GET", Path: "/api/resume.json", Tag: "Resume", Summary: "Export experiences, projects and skills as a JSON Resume", Status: 200, Response: dto.Resume{}, Errors: failed},
+	{Method: "POST", Path: "/api/resume/import", Tag: "Resume", Summary: "Create or update experiences and projects from a JSON Resume in one transaction", Body: dto.Resume{}, Status: 200, Response: model.ResumeImport{}, Errors: bad, Admin: true},
+}
+
+// v2 lists the resources whose shape changed in v2.
+var v2 = []Operation{
+	{Method: "GET", Path: "/projects", Tag: "Projects", Summary: "List projects with slugs and tags", Status: 200, Response: []dto.ProjectV2{}, Errors: failed},
+	{Method: "POST", Path: "/projects", Tag: "Projects", Summary: "Create a project; the slug defaults to one derived from the name", Body: dto.ProjectV2{}, Status: 201, Response: dto.ProjectV2{}, Errors: bad},
+	{Method: "GET", Path: "/projects/{slug}", Tag: "Projects", Summary: "Get a project", Status: 200, Response: dto.ProjectV2{}, Errors: []int{http.StatusNotFound, http.StatusInternalServerError}},
+	{Method: "PUT", Path: "/projects/{slug}", Tag: "Projects", Summary: "Replace a project; a new slug in the body renames it", Body: dto.ProjectV2{}, Status: 200, Response: dto.ProjectV2{}, Errors: notFound},
+	{Method: "DELETE", Path: "/projects/{slug}", Tag: "Projects", Summary: "Delete a project", Status: 204, Errors: []int{http.StatusNotFound, http.StatusInternalServerError}},
+
+	{Method: "GET", Path: "/experiences", Tag: "Experiences", Summary: "List experiences, current positions first", Status: 200, Response: []dto.ExperienceV2{}, Errors: failed},
+	{Method: "POST", Path: "/experiences", Tag: "Experiences", Summary: "Create an experience", Body: dto.ExperienceV2{}, Status: 201, Response: dto.ExperienceV2{}, Errors: bad},
+	{Method: "PUT", Path: "/experiences", Tag: "Experiences", Summary: "Update an experience", Body: dto.ExperienceV2{}, Status: 200, Response: dto.ExperienceV2{}, Errors: bad},
+	{Method: "DELETE", Path: "/experiences/{id}", Tag: "Experiences", Summary: "Delete an experience", Status: 204, Errors: badID},
+}
+
+func prefixed(prefix string, ops []Operation, deprecated bool) []Operation {
+	out := make([]Operation, len(ops))
+	for i, op := range ops {
+		op.Path = prefix + op.Path
+		op.Deprecated = deprecated
+		out[i] = op
+	}
+	return out
+}
+
+func concat(groups ...[]Operation) []Operation {
+	var out []Operation
+	for _, g := range groups {
+		out = append(out, g...)
+	}
+	return out
+}
@@ -0,0 +1,110 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"porto/model"
+)
+
+// Schema is an OpenAPI schema object; only the keywords we need are set.
+type Schema map[string]any
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	dateType = reflect.TypeOf(model.Date{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// enums lists the accepted values of string fields, keyed by
+// "Type.json_name".
+var enums = map[string][]string{
+	"Contact.status":     model.ContactStatuses,
+	"Skill.proficiency":  model.ProficiencyLevels,
+	"Job.status":         {model.JobQueued, model.JobRunning, model.JobDone, model.JobDead},
+	"Subscriber.status":  {model.SubscriberPending, model.SubscriberConfirmed, model.SubscriberUnsubscribed},
+	"Testimonial.status": {model.TestimonialPending, model.TestimonialApproved, model.TestimonialRejected},
+}
+
+// schemas collects the named struct schemas referenced from operations so
+// they can be emitted once under components.
+type schemas map[string]Schema
+
+// of returns the schema for t. Named structs are stored in s and referenced
+// with $ref.
+func (s schemas) of(t reflect.Type) Schema {
+	switch t {
+	case timeType:
+		return Schema{"type": "string", "format": "date-time"}
+	case dateType:
+		return Schema{"type": "string", "format": "date", "nullable": true}
+	case rawType:
+		return Schema{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := s.of(t.Elem())
+		if _, isRef := schema["$ref"]; isRef {
+			return Schema{"allOf": []Schema{schema}, "nullable": true}
+		}
+		schema["nullable"] = true
+		return schema
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return Schema{"type": "integer"}
+	case reflect.Int64:
+		return Schema{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": s.of(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": s.of(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		if _, ok := s[t.Name()]; !ok {
+			s[t.Name()] = nil // reserve the name for recursive types
+			s[t.Name()] = s.object(t)
+		}
+		return Schema{"$ref": "#/components/schemas/" + t.Name()}
+	}
+	return Schema{}
+}
+
+// object describes a struct by its JSON field names. Embedded structs are
+// flattened the way encoding/json flattens them.
+func (s schemas) object(t reflect.Type) Schema {
+	props := Schema{}
+	s.fields(t, t.Name(), props)
+	return Schema{"type": "object", "properties": props}
+}
+
+func (s schemas) fields(t reflect.Type, owner string, props Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			s.fields(f.Type, f.Type.Name(), props)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		schema := s.of(f.Type)
+		if values, ok := enums[owner+"."+name]; ok {
+			schema["enum"] = values
+		}
+		props[name] = schema
+	}
+}
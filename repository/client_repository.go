@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type ClientRepository interface {
+	GetAll(ctx context.Context) ([]model.Client, error)
+	GetByID(ctx context.Context, id int) (*model.Client, error)
+	Create(ctx context.Context, c *model.Client) error
+	Update(ctx context.Context, c *model.Client) error
+	Delete(ctx context.Context, id int) error
+	// Reorder assigns sort orders 1..n following the given ids.
+	Reorder(ctx context.Context, ids []int) error
+}
+
+type clientRepository struct {
+	db *sql.DB
+}
+
+func NewClientRepository(db *sql.DB) ClientRepository {
+	return &clientRepository{db}
+}
+
+const clientSelect = "SELECT c.id, c.name, c.website, c.logo_media_id, m.url, c.sort_order FROM clients c JOIN media m ON m.id = c.logo_media_id"
+
+func (r *clientRepository) GetAll(ctx context.Context) ([]model.Client, error) {
+	defer metrics.ObserveQuery("client", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, clientSelect+" ORDER BY c.sort_order, c.name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var clients []model.Client
+	for rows.Next() {
+		var c model.Client
+		if err := rows.Scan(&c.ID, &c.Name, &c.Website, &c.LogoMediaID, &c.LogoURL, &c.SortOrder); err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadPortfolioIDs(ctx, clients); err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (r *clientRepository) GetByID(ctx context.Context, id int) (*model.Client, error) {
+	defer metrics.ObserveQuery("client", "GetByID", time.Now())
+	var c model.Client
+	err := r.db.QueryRowContext(ctx, clientSelect+" WHERE c.id=$1", id).Scan(&c.ID, &c.Name, &c.Website, &c.LogoMediaID, &c.LogoURL, &c.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+	clients := []model.Client{c}
+	if err := r.loadPortfolioIDs(ctx, clients); err != nil {
+		return nil, err
+	}
+	return &clients[0], nil
+}
+
+func (r *clientRepository) Create(ctx context.Context, c *model.Client) error {
+	defer metrics.ObserveQuery("client", "Create", time.Now())
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(ctx, "INSERT INTO clients (name, website, logo_media_id, sort_order) VALUES ($1, $2, $3, $4) RETURNING id", c.Name, c.Website, c.LogoMediaID, c.SortOrder).Scan(&c.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := replaceClientPortfolios(ctx, tx, c); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *clientRepository) Update(ctx context.Context, c *model.Client) error {
+	defer metrics.ObserveQuery("client", "Update", time.Now())
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE clients SET name=$1, website=$2, logo_media_id=$3, sort_order=$4 WHERE id=$5", c.Name, c.Website, c.LogoMediaID, c.SortOrder, c.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := replaceClientPortfolios(ctx, tx, c); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *clientRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("client", "Delete", time.Now())
+	_, err := r.db.ExecContext(ctx, "DELETE FROM clients WHERE id=$1", id)
+	return err
+}
+
+func (r *clientRepository) Reorder(ctx context.Context, ids []int) error {
+	defer metrics.ObserveQuery("client", "Reorder", time.Now())
+	return reorder(ctx, r.db, "clients", ids)
+}
+
+func (r *clientRepository) loadPortfolioIDs(ctx context.Context, clients []model.Client) error {
+	if len(clients) == 0 {
+		return nil
+	}
+	index := make(map[int]*model.Client, len(clients))
+	for i := range clients {
+		index[clients[i].ID] = &clients[i]
+	}
+	rows, err := r.db.QueryContext(ctx, "SELECT client_id, portfolio_id FROM client_portfolios")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var clientID, portfolioID int
+		if err := rows.Scan(&clientID, &portfolioID); err != nil {
+			return err
+		}
+		if c, ok := index[clientID]; ok {
+			c.PortfolioIDs = append(c.PortfolioIDs, portfolioID)
+		}
+	}
+	return rows.Err()
+}
+
+func replaceClientPortfolios(ctx context.Context, tx *sql.Tx, c *model.Client) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM client_portfolios WHERE client_id=$1", c.ID); err != nil {
+		return err
+	}
+	for _, pid := range c.PortfolioIDs {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO client_portfolios (client_id, portfolio_id) VALUES ($1, $2)", c.ID, pid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
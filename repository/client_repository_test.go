@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestClientRepository_GetAll(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewClientRepository(db)
+
+	// success
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT c.id, c.name, c.website, c.logo_media_id, m.url, c.sort_order FROM clients c JOIN media m ON m.id = c.logo_media_id ORDER BY c.sort_order, c.name")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "website", "logo_media_id", "url", "sort_order"}).
+			AddRow(1, "Acme", "https://acme.test", 3, "/uploads/acme.png", 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT client_id, portfolio_id FROM client_portfolios")).
+		WillReturnRows(sqlmock.NewRows([]string{"client_id", "portfolio_id"}).AddRow(1, 10))
+	result, err := repo.GetAll(context.Background())
+	if err != nil || len(result) != 1 {
+		t.Fatalf("expected 1 result, got %v, err %v", result, err)
+	}
+	if result[0].LogoURL != "/uploads/acme.png" || len(result[0].PortfolioIDs) != 1 {
+		t.Errorf("expected logo and portfolio links, got %+v", result[0])
+	}
+
+	// error
+	mock.ExpectQuery(regexp.QuoteMeta("FROM clients c JOIN media m")).
+		WillReturnError(sql.ErrConnDone)
+	_, err = repo.GetAll(context.Background())
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClientRepository_Create(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewClientRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO clients (name, website, logo_media_id, sort_order) VALUES ($1, $2, $3, $4) RETURNING id")).
+		WithArgs("Acme", "https://acme.test", 3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM client_portfolios WHERE client_id=$1")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO client_portfolios (client_id, portfolio_id) VALUES ($1, $2)")).
+		WithArgs(1, 10).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c := &model.Client{Name: "Acme", Website: "https://acme.test", LogoMediaID: 3, SortOrder: 1, PortfolioIDs: []int{10}}
+	if err := repo.Create(context.Background(), c); err != nil || c.ID != 1 {
+		t.Errorf("expected id 1, got %v, err %v", c.ID, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
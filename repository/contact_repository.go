@@ -3,13 +3,25 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"porto/metrics"
 	"porto/model"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type ContactRepository interface {
-	GetAll(ctx context.Context) ([]model.Contact, error)
+	// GetAll lists contacts newest first with the given status. An empty
+	// status lists the inbox: every status but spam.
+	GetAll(ctx context.Context, status string) ([]model.Contact, error)
 	GetByID(ctx context.Context, id int) (*model.Contact, error)
 	Create(ctx context.Context, c *model.Contact) error
+	// UpdateStatus sets the status of every listed contact and returns how
+	// many were changed.
+	UpdateStatus(ctx context.Context, ids []int, status string) (int, error)
+	// UpdateNotes returns sql.ErrNoRows when no contact has the given id.
+	UpdateNotes(ctx context.Context, id int, notes string) error
+	CountByStatus(ctx context.Context) (map[string]int, error)
 	Delete(ctx context.Context, id int) error
 }
 
@@ -21,8 +33,19 @@ func NewContactRepository(db *sql.DB) ContactRepository {
 	return &contactRepository{db}
 }
 
-func (r *contactRepository) GetAll(ctx context.Context) ([]model.Contact, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT id, name, email, message FROM contacts")
+const contactColumns = "id, name, email, subject, message, status, notes, created_at, spam_score, spam_reasons"
+
+func (r *contactRepository) GetAll(ctx context.Context, status string) ([]model.Contact, error) {
+	defer metrics.ObserveQuery("contact", "GetAll", time.Now())
+	query := "SELECT " + contactColumns + " FROM contacts"
+	var args []any
+	if status != "" {
+		query += " WHERE status=$1"
+		args = append(args, status)
+	} else {
+		query += " WHERE status<>'" + model.ContactSpam + "'"
+	}
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY created_at DESC, id DESC", args...)
 	if err != nil {
 		return nil, err
 	}
@@ -30,17 +53,19 @@ func (r *contactRepository) GetAll(ctx context.Context) ([]model.Contact, error)
 	var contacts []model.Contact
 	for rows.Next() {
 		var c model.Contact
-		if err := rows.Scan(&c.ID, &c.Name, &c.Email, &c.Message); err != nil {
+		if err := rows.Scan(&c.ID, &c.Name, &c.Email, &c.Subject, &c.Message, &c.Status, &c.Notes, &c.CreatedAt, &c.SpamScore, &c.SpamReasons); err != nil {
 			return nil, err
 		}
 		contacts = append(contacts, c)
 	}
-	return contacts, nil
+	return contacts, rows.Err()
 }
 
 func (r *contactRepository) GetByID(ctx context.Context, id int) (*model.Contact, error) {
+	defer metrics.ObserveQuery("contact", "GetByID", time.Now())
 	var c model.Contact
-	err := r.db.QueryRowContext(ctx, "SELECT id, name, email, message FROM contacts WHERE id=$1", id).Scan(&c.ID, &c.Name, &c.Email, &c.Message)
+	err := r.db.QueryRowContext(ctx, "SELECT "+contactColumns+" FROM contacts WHERE id=$1", id).
+		Scan(&c.ID, &c.Name, &c.Email, &c.Subject, &c.Message, &c.Status, &c.Notes, &c.CreatedAt, &c.SpamScore, &c.SpamReasons)
 	if err != nil {
 		return nil, err
 	}
@@ -48,10 +73,58 @@ func (r *contactRepository) GetByID(ctx context.Context, id int) (*model.Contact
 }
 
 func (r *contactRepository) Create(ctx context.Context, c *model.Contact) error {
-	return r.db.QueryRowContext(ctx, "INSERT INTO contacts (name, email, message) VALUES ($1, $2, $3) RETURNING id", c.Name, c.Email, c.Message).Scan(&c.ID)
+	defer metrics.ObserveQuery("contact", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO contacts (name, email, subject, message, status, spam_score, spam_reasons) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at",
+		c.Name, c.Email, c.Subject, c.Message, c.Status, c.SpamScore, c.SpamReasons).Scan(&c.ID, &c.CreatedAt)
+}
+
+func (r *contactRepository) UpdateStatus(ctx context.Context, ids []int, status string) (int, error) {
+	defer metrics.ObserveQuery("contact", "UpdateStatus", time.Now())
+	res, err := r.db.ExecContext(ctx, "UPDATE contacts SET status=$1 WHERE id = ANY($2)", status, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func (r *contactRepository) UpdateNotes(ctx context.Context, id int, notes string) error {
+	defer metrics.ObserveQuery("contact", "UpdateNotes", time.Now())
+	res, err := r.db.ExecContext(ctx, "UPDATE contacts SET notes=$1 WHERE id=$2", notes, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *contactRepository) CountByStatus(ctx context.Context) (map[string]int, error) {
+	defer metrics.ObserveQuery("contact", "CountByStatus", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT status, COUNT(*) FROM contacts GROUP BY status")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := map[string]int{}
+	for rows.Next() {
+		var status string
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return nil, err
+		}
+		counts[status] = n
+	}
+	return counts, rows.Err()
 }
 
 func (r *contactRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("contact", "Delete", time.Now())
 	_, err := r.db.ExecContext(ctx, "DELETE FROM contacts WHERE id=$1", id)
 	return err
 }
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+)
+
+func TestContactRepository_GetAll(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewContactRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, email, subject, message, status, notes, created_at, spam_score, spam_reasons FROM contacts WHERE status=$1 ORDER BY created_at DESC, id DESC")).
+		WithArgs("new").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "subject", "message", "status", "notes", "created_at", "spam_score", "spam_reasons"}).
+			AddRow(1, "A", "a@mail.com", "Hi", "hello", "new", "", time.Now(), 0, ""))
+	contacts, err := repo.GetAll(context.Background(), model.ContactNew)
+	if err != nil || len(contacts) != 1 || contacts[0].Subject != "Hi" {
+		t.Errorf("expected 1 contact, got %v, err %v", contacts, err)
+	}
+}
+
+func TestContactRepository_GetAll_ExcludesSpam(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewContactRepository(db)
+
+	// the inbox leaves spam out
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, email, subject, message, status, notes, created_at, spam_score, spam_reasons FROM contacts WHERE status<>'spam' ORDER BY created_at DESC, id DESC")).
+		WithArgs().
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "subject", "message", "status", "notes", "created_at", "spam_score", "spam_reasons"}).
+			AddRow(1, "A", "a@mail.com", "Hi", "hello", "new", "", time.Now(), 0, ""))
+	if _, err := repo.GetAll(context.Background(), ""); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	// spam only when asked for
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, email, subject, message, status, notes, created_at, spam_score, spam_reasons FROM contacts WHERE status=$1 ORDER BY created_at DESC, id DESC")).
+		WithArgs("spam").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "subject", "message", "status", "notes", "created_at", "spam_score", "spam_reasons"}).
+			AddRow(2, "B", "b@mail.com", "Buy", "cheap", "spam", "", time.Now(), 9, "links"))
+	contacts, err := repo.GetAll(context.Background(), model.ContactSpam)
+	if err != nil || len(contacts) != 1 || contacts[0].Status != model.ContactSpam {
+		t.Errorf("expected the spam contact, got %v, err %v", contacts, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestContactRepository_UpdateStatus(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewContactRepository(db)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE contacts SET status=$1 WHERE id = ANY($2)")).
+		WithArgs("archived", pq.Array([]int{1, 2})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	n, err := repo.UpdateStatus(context.Background(), []int{1, 2}, model.ContactArchived)
+	if err != nil || n != 2 {
+		t.Errorf("expected 2 updated, got %d, err %v", n, err)
+	}
+}
+
+func TestContactRepository_CountByStatus(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewContactRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT status, COUNT(*) FROM contacts GROUP BY status")).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "count"}).AddRow("new", 3).AddRow("read", 1))
+	counts, err := repo.CountByStatus(context.Background())
+	if err != nil || counts["new"] != 3 || counts["read"] != 1 {
+		t.Errorf("unexpected counts %v, err %v", counts, err)
+	}
+}
+
+func TestContactRepository_Create(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewContactRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO contacts (name, email, subject, message, status, spam_score, spam_reasons) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at")).
+		WithArgs("A", "a@mail.com", "Hi", "hello", "spam", 5, "honeypot").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()))
+	c := &model.Contact{Name: "A", Email: "a@mail.com", Subject: "Hi", Message: "hello", Status: model.ContactSpam, SpamScore: 5, SpamReasons: "honeypot"}
+	if err := repo.Create(context.Background(), c); err != nil || c.ID != 1 {
+		t.Errorf("expected id 1, got %d, err %v", c.ID, err)
+	}
+}
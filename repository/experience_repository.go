@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"porto/metrics"
 	"porto/model"
+	"time"
 )
 
 type ExperienceRepository interface {
@@ -22,8 +24,15 @@ func NewExperienceRepository(db *sql.DB) ExperienceRepository {
 	return &experienceRepository{db}
 }
 
+const experienceColumns = "id, title, company, start_date, end_date, is_current, description, translations"
+
+func scanExperience(row interface{ Scan(dest ...any) error }, e *model.Experience) error {
+	return row.Scan(&e.ID, &e.Title, &e.Company, &e.StartDate, &e.EndDate, &e.IsCurrent, &e.Description, &e.Translations)
+}
+
 func (r *experienceRepository) GetAll(ctx context.Context) ([]model.Experience, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT id, title, company, start_date, end_date, description FROM experiences")
+	defer metrics.ObserveQuery("experience", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT "+experienceColumns+" FROM experiences")
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +40,7 @@ func (r *experienceRepository) GetAll(ctx context.Context) ([]model.Experience,
 	var experiences []model.Experience
 	for rows.Next() {
 		var e model.Experience
-		if err := rows.Scan(&e.ID, &e.Title, &e.Company, &e.StartDate, &e.EndDate, &e.Description); err != nil {
+		if err := scanExperience(rows, &e); err != nil {
 			return nil, err
 		}
 		experiences = append(experiences, e)
@@ -40,24 +49,30 @@ func (r *experienceRepository) GetAll(ctx context.Context) ([]model.Experience,
 }
 
 func (r *experienceRepository) GetByID(ctx context.Context, id int) (*model.Experience, error) {
+	defer metrics.ObserveQuery("experience", "GetByID", time.Now())
 	var e model.Experience
-	err := r.db.QueryRowContext(ctx, "SELECT id, title, company, start_date, end_date, description FROM experiences WHERE id=$1", id).Scan(&e.ID, &e.Title, &e.Company, &e.StartDate, &e.EndDate, &e.Description)
-	if err != nil {
+	if err := scanExperience(r.db.QueryRowContext(ctx, "SELECT "+experienceColumns+" FROM experiences WHERE id=$1", id), &e); err != nil {
 		return nil, err
 	}
 	return &e, nil
 }
 
 func (r *experienceRepository) Create(ctx context.Context, e *model.Experience) error {
-	return r.db.QueryRowContext(ctx, "INSERT INTO experiences (title, company, start_date, end_date, description) VALUES ($1, $2, $3, $4, $5) RETURNING id", e.Title, e.Company, e.StartDate, e.EndDate, e.Description).Scan(&e.ID)
+	defer metrics.ObserveQuery("experience", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO experiences (title, company, start_date, end_date, is_current, description, translations) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '{}'::jsonb)) RETURNING id",
+		e.Title, e.Company, e.StartDate, e.EndDate, e.IsCurrent, e.Description, e.Translations).Scan(&e.ID)
 }
 
 func (r *experienceRepository) Update(ctx context.Context, e *model.Experience) error {
-	_, err := r.db.ExecContext(ctx, "UPDATE experiences SET title=$1, company=$2, start_date=$3, end_date=$4, description=$5 WHERE id=$6", e.Title, e.Company, e.StartDate, e.EndDate, e.Description, e.ID)
+	defer metrics.ObserveQuery("experience", "Update", time.Now())
+	// Nil translations keep the stored ones; see model.Translations.
+	_, err := r.db.ExecContext(ctx, "UPDATE experiences SET title=$1, company=$2, start_date=$3, end_date=$4, is_current=$5, description=$6, translations=COALESCE($7, translations) WHERE id=$8",
+		e.Title, e.Company, e.StartDate, e.EndDate, e.IsCurrent, e.Description, e.Translations, e.ID)
 	return err
 }
 
 func (r *experienceRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("experience", "Delete", time.Now())
 	_, err := r.db.ExecContext(ctx, "DELETE FROM experiences WHERE id=$1", id)
 	return err
 }
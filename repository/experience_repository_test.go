@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"porto/model"
 
@@ -17,9 +18,9 @@ func TestExperienceRepository_GetAll(t *testing.T) {
 	repo := NewExperienceRepository(db)
 
 	// success
-	rows := sqlmock.NewRows([]string{"id", "title", "company", "start_date", "end_date", "description"}).
-		AddRow(1, "A", "B", "2020", "2021", "desc")
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, description FROM experiences")).
+	rows := sqlmock.NewRows([]string{"id", "title", "company", "start_date", "end_date", "is_current", "description", "translations"}).
+		AddRow(1, "A", "B", "2020-01-01", "2021-06-30", false, "desc", "{}")
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, is_current, description, translations FROM experiences")).
 		WillReturnRows(rows)
 	result, err := repo.GetAll(context.Background())
 	if err != nil || len(result) != 1 {
@@ -27,7 +28,7 @@ func TestExperienceRepository_GetAll(t *testing.T) {
 	}
 
 	// error
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, description FROM experiences")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, is_current, description, translations FROM experiences")).
 		WillReturnError(sql.ErrConnDone)
 	_, err = repo.GetAll(context.Background())
 	if err == nil {
@@ -41,16 +42,16 @@ func TestExperienceRepository_GetByID(t *testing.T) {
 	repo := NewExperienceRepository(db)
 
 	// success
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, description FROM experiences WHERE id=$1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, is_current, description, translations FROM experiences WHERE id=$1")).
 		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "company", "start_date", "end_date", "description"}).AddRow(1, "A", "B", "2020", "2021", "desc"))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "company", "start_date", "end_date", "is_current", "description", "translations"}).AddRow(1, "A", "B", "2020-01-01", "2021-06-30", false, "desc", "{}"))
 	_, err := repo.GetByID(context.Background(), 1)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
 
 	// error
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, description FROM experiences WHERE id=$1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, company, start_date, end_date, is_current, description, translations FROM experiences WHERE id=$1")).
 		WithArgs(2).
 		WillReturnError(sql.ErrNoRows)
 	_, err = repo.GetByID(context.Background(), 2)
@@ -65,20 +66,20 @@ func TestExperienceRepository_Create(t *testing.T) {
 	repo := NewExperienceRepository(db)
 
 	// success
-	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO experiences (title, company, start_date, end_date, description) VALUES ($1, $2, $3, $4, $5) RETURNING id")).
-		WithArgs("A", "B", "2020", "2021", "desc").
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO experiences (title, company, start_date, end_date, is_current, description, translations) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '{}'::jsonb)) RETURNING id")).
+		WithArgs("A", "B", "2020-01-01", "2021-06-30", false, "desc", nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
-	e := &model.Experience{Title: "A", Company: "B", StartDate: "2020", EndDate: "2021", Description: "desc"}
+	e := &model.Experience{Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.June, 30), Description: "desc"}
 	err := repo.Create(context.Background(), e)
 	if err != nil || e.ID != 1 {
 		t.Errorf("expected id 1, got %v, err %v", e.ID, err)
 	}
 
 	// error
-	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO experiences (title, company, start_date, end_date, description) VALUES ($1, $2, $3, $4, $5) RETURNING id")).
-		WithArgs("B", "C", "2020", "2021", "desc").
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO experiences (title, company, start_date, end_date, is_current, description, translations) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '{}'::jsonb)) RETURNING id")).
+		WithArgs("B", "C", "2020-01-01", "2021-06-30", false, "desc", nil).
 		WillReturnError(sql.ErrConnDone)
-	e2 := &model.Experience{Title: "B", Company: "C", StartDate: "2020", EndDate: "2021", Description: "desc"}
+	e2 := &model.Experience{Title: "B", Company: "C", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.June, 30), Description: "desc"}
 	err = repo.Create(context.Background(), e2)
 	if err == nil {
 		t.Error("expected error")
@@ -91,20 +92,20 @@ func TestExperienceRepository_Update(t *testing.T) {
 	repo := NewExperienceRepository(db)
 
 	// success
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE experiences SET title=$1, company=$2, start_date=$3, end_date=$4, description=$5 WHERE id=$6")).
-		WithArgs("A", "B", "2020", "2021", "desc", 1).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE experiences SET title=$1, company=$2, start_date=$3, end_date=$4, is_current=$5, description=$6, translations=COALESCE($7, translations) WHERE id=$8")).
+		WithArgs("A", "B", "2020-01-01", "2021-06-30", false, "desc", nil, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
-	e := &model.Experience{ID: 1, Title: "A", Company: "B", StartDate: "2020", EndDate: "2021", Description: "desc"}
+	e := &model.Experience{ID: 1, Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.June, 30), Description: "desc"}
 	err := repo.Update(context.Background(), e)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
 
 	// error
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE experiences SET title=$1, company=$2, start_date=$3, end_date=$4, description=$5 WHERE id=$6")).
-		WithArgs("B", "C", "2020", "2021", "desc", 2).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE experiences SET title=$1, company=$2, start_date=$3, end_date=$4, is_current=$5, description=$6, translations=COALESCE($7, translations) WHERE id=$8")).
+		WithArgs("B", "C", "2020-01-01", "2021-06-30", false, "desc", nil, 2).
 		WillReturnError(sql.ErrConnDone)
-	e2 := &model.Experience{ID: 2, Title: "B", Company: "C", StartDate: "2020", EndDate: "2021", Description: "desc"}
+	e2 := &model.Experience{ID: 2, Title: "B", Company: "C", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.June, 30), Description: "desc"}
 	err = repo.Update(context.Background(), e2)
 	if err == nil {
 		t.Error("expected error")
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type JobRepository interface {
+	// GetAll returns the most recent jobs, optionally filtered by status.
+	GetAll(ctx context.Context, status string) ([]model.Job, error)
+	Enqueue(ctx context.Context, j *model.Job) error
+	// Claim locks the next runnable job for lease and marks it running. It
+	// returns sql.ErrNoRows when there is nothing to do. A running job whose
+	// lease expired, e.g. because its worker crashed, is claimable again.
+	Claim(ctx context.Context, lease time.Duration) (*model.Job, error)
+	MarkDone(ctx context.Context, id int) error
+	// Reschedule queues a failed job to run again at runAt.
+	Reschedule(ctx context.Context, id int, lastErr string, runAt time.Time) error
+	MarkDead(ctx context.Context, id int, lastErr string) error
+	// Retry re-queues a dead job with fresh attempts. It returns
+	// sql.ErrNoRows when no dead job has the given id.
+	Retry(ctx context.Context, id int) error
+}
+
+type jobRepository struct {
+	db *sql.DB
+}
+
+func NewJobRepository(db *sql.DB) JobRepository {
+	return &jobRepository{db}
+}
+
+const jobColumns = "id, kind, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at"
+
+func (r *jobRepository) GetAll(ctx context.Context, status string) ([]model.Job, error) {
+	defer metrics.ObserveQuery("job", "GetAll", time.Now())
+	query := "SELECT " + jobColumns + " FROM jobs"
+	var args []any
+	if status != "" {
+		query += " WHERE status=$1"
+		args = append(args, status)
+	}
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY id DESC LIMIT 100", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var jobs []model.Job
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *j)
+	}
+	return jobs, rows.Err()
+}
+
+func (r *jobRepository) Enqueue(ctx context.Context, j *model.Job) error {
+	defer metrics.ObserveQuery("job", "Enqueue", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO jobs (kind, payload, max_attempts) VALUES ($1, $2, $3) RETURNING id, status, run_at, created_at, updated_at",
+		j.Kind, []byte(j.Payload), j.MaxAttempts).Scan(&j.ID, &j.Status, &j.RunAt, &j.CreatedAt, &j.UpdatedAt)
+}
+
+func (r *jobRepository) Claim(ctx context.Context, lease time.Duration) (*model.Job, error) {
+	defer metrics.ObserveQuery("job", "Claim", time.Now())
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	j, err := scanJob(tx.QueryRowContext(ctx, "SELECT "+jobColumns+" FROM jobs WHERE status IN ('queued', 'running') AND run_at <= NOW() ORDER BY run_at, id LIMIT 1 FOR UPDATE SKIP LOCKED"))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.QueryRowContext(ctx, "UPDATE jobs SET status='running', attempts=attempts+1, run_at=NOW()+$1*INTERVAL '1 second', updated_at=NOW() WHERE id=$2 RETURNING status, attempts, run_at, updated_at",
+		lease.Seconds(), j.ID).Scan(&j.Status, &j.Attempts, &j.RunAt, &j.UpdatedAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return j, tx.Commit()
+}
+
+func (r *jobRepository) MarkDone(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("job", "MarkDone", time.Now())
+	_, err := r.db.ExecContext(ctx, "UPDATE jobs SET status='done', last_error='', updated_at=NOW() WHERE id=$1", id)
+	return err
+}
+
+func (r *jobRepository) Reschedule(ctx context.Context, id int, lastErr string, runAt time.Time) error {
+	defer metrics.ObserveQuery("job", "Reschedule", time.Now())
+	_, err := r.db.ExecContext(ctx, "UPDATE jobs SET status='queued', last_error=$1, run_at=$2, updated_at=NOW() WHERE id=$3", lastErr, runAt, id)
+	return err
+}
+
+func (r *jobRepository) MarkDead(ctx context.Context, id int, lastErr string) error {
+	defer metrics.ObserveQuery("job", "MarkDead", time.Now())
+	_, err := r.db.ExecContext(ctx, "UPDATE jobs SET status='dead', last_error=$1, updated_at=NOW() WHERE id=$2", lastErr, id)
+	return err
+}
+
+func (r *jobRepository) Retry(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("job", "Retry", time.Now())
+	res, err := r.db.ExecContext(ctx, "UPDATE jobs SET status='queued', attempts=0, run_at=NOW(), updated_at=NOW() WHERE id=$1 AND status='dead'", id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanJob(row interface{ Scan(dest ...any) error }) (*model.Job, error) {
+	var j model.Job
+	var payload []byte
+	if err := row.Scan(&j.ID, &j.Kind, &payload, &j.Status, &j.Attempts, &j.MaxAttempts, &j.RunAt, &j.LastError, &j.CreatedAt, &j.UpdatedAt); err != nil {
+		return nil, err
+	}
+	j.Payload = payload
+	return &j, nil
+}
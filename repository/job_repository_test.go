@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var jobRowColumns = []string{"id", "kind", "payload", "status", "attempts", "max_attempts", "run_at", "last_error", "created_at", "updated_at"}
+
+func TestJobRepository_Claim(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewJobRepository(db)
+	now := time.Now()
+
+	// success
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM jobs WHERE status IN ('queued', 'running') AND run_at <= NOW() ORDER BY run_at, id LIMIT 1 FOR UPDATE SKIP LOCKED")).
+		WillReturnRows(sqlmock.NewRows(jobRowColumns).AddRow(1, "send_mail", []byte(`{"Subject":"hi"}`), "queued", 0, 5, now, "", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE jobs SET status='running', attempts=attempts+1")).
+		WithArgs(float64(600), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "attempts", "run_at", "updated_at"}).AddRow("running", 1, now.Add(10*time.Minute), now))
+	mock.ExpectCommit()
+	job, err := repo.Claim(context.Background(), 10*time.Minute)
+	if err != nil || job.ID != 1 || job.Attempts != 1 || job.Status != "running" {
+		t.Fatalf("expected claimed job 1, got %+v, err %v", job, err)
+	}
+	if string(job.Payload) != `{"Subject":"hi"}` {
+		t.Errorf("expected payload to be loaded, got %s", job.Payload)
+	}
+
+	// empty queue
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FOR UPDATE SKIP LOCKED")).WillReturnRows(sqlmock.NewRows(jobRowColumns))
+	mock.ExpectRollback()
+	if _, err := repo.Claim(context.Background(), time.Minute); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJobRepository_Retry(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewJobRepository(db)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE jobs SET status='queued', attempts=0, run_at=NOW(), updated_at=NOW() WHERE id=$1 AND status='dead'")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := repo.Retry(context.Background(), 1); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE jobs SET status='queued'")).
+		WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := repo.Retry(context.Background(), 2); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type MediaRepository interface {
+	GetAll(ctx context.Context) ([]model.Media, error)
+	GetByID(ctx context.Context, id int) (*model.Media, error)
+	Create(ctx context.Context, m *model.Media) error
+	Delete(ctx context.Context, id int) error
+}
+
+type mediaRepository struct {
+	db *sql.DB
+}
+
+func NewMediaRepository(db *sql.DB) MediaRepository {
+	return &mediaRepository{db}
+}
+
+func (r *mediaRepository) GetAll(ctx context.Context) ([]model.Media, error) {
+	defer metrics.ObserveQuery("media", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT id, file_name, content_type, size, url, created_at FROM media ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var media []model.Media
+	for rows.Next() {
+		var m model.Media
+		if err := rows.Scan(&m.ID, &m.FileName, &m.ContentType, &m.Size, &m.URL, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+	return media, rows.Err()
+}
+
+func (r *mediaRepository) GetByID(ctx context.Context, id int) (*model.Media, error) {
+	defer metrics.ObserveQuery("media", "GetByID", time.Now())
+	var m model.Media
+	err := r.db.QueryRowContext(ctx, "SELECT id, file_name, content_type, size, url, created_at FROM media WHERE id=$1", id).Scan(&m.ID, &m.FileName, &m.ContentType, &m.Size, &m.URL, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *mediaRepository) Create(ctx context.Context, m *model.Media) error {
+	defer metrics.ObserveQuery("media", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO media (file_name, content_type, size, url) VALUES ($1, $2, $3, $4) RETURNING id, created_at", m.FileName, m.ContentType, m.Size, m.URL).Scan(&m.ID, &m.CreatedAt)
+}
+
+func (r *mediaRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("media", "Delete", time.Now())
+	_, err := r.db.ExecContext(ctx, "DELETE FROM media WHERE id=$1", id)
+	return err
+}
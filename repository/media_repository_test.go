@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestMediaRepository_Create(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewMediaRepository(db)
+
+	// success
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO media (file_name, content_type, size, url) VALUES ($1, $2, $3, $4) RETURNING id, created_at")).
+		WithArgs("logo.png", "image/png", int64(42), "/uploads/abc.png").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now()))
+	m := &model.Media{FileName: "logo.png", ContentType: "image/png", Size: 42, URL: "/uploads/abc.png"}
+	if err := repo.Create(context.Background(), m); err != nil || m.ID != 1 {
+		t.Errorf("expected id 1, got %v, err %v", m.ID, err)
+	}
+
+	// error
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO media")).
+		WillReturnError(sql.ErrConnDone)
+	if err := repo.Create(context.Background(), &model.Media{}); err == nil {
+		t.Error("expected error")
+	}
+}
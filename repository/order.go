@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+// reorder assigns sort_order 1..n in table following ids, in one
+// transaction. table must be a constant, never user input.
+func reorder(ctx context.Context, db *sql.DB, table string, ids []int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		if _, err := tx.ExecContext(ctx, "UPDATE "+table+" SET sort_order=$1 WHERE id=$2", i+1, id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
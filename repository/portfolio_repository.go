@@ -3,12 +3,17 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"porto/metrics"
 	"porto/model"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 type PortfolioRepository interface {
 	GetAll(ctx context.Context) ([]model.Portfolio, error)
 	GetByID(ctx context.Context, id int) (*model.Portfolio, error)
+	GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error)
 	Create(ctx context.Context, p *model.Portfolio) error
 	Update(ctx context.Context, p *model.Portfolio) error
 	Delete(ctx context.Context, id int) error
@@ -22,8 +27,15 @@ func NewPortfolioRepository(db *sql.DB) PortfolioRepository {
 	return &portfolioRepository{db}
 }
 
+const portfolioColumns = "id, name, description, image_url, link, slug, tags, created_at, updated_at, translations"
+
+func scanPortfolio(row interface{ Scan(dest ...any) error }, p *model.Portfolio) error {
+	return row.Scan(&p.ID, &p.Name, &p.Description, &p.ImageURL, &p.Link, &p.Slug, pq.Array(&p.Tags), &p.CreatedAt, &p.UpdatedAt, &p.Translations)
+}
+
 func (r *portfolioRepository) GetAll(ctx context.Context) ([]model.Portfolio, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT id, name, description, image_url, link FROM portfolios")
+	defer metrics.ObserveQuery("portfolio", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT "+portfolioColumns+" FROM portfolios")
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +43,7 @@ func (r *portfolioRepository) GetAll(ctx context.Context) ([]model.Portfolio, er
 	var portfolios []model.Portfolio
 	for rows.Next() {
 		var p model.Portfolio
-		if err := rows.Scan(&p.ID, &p.Name, &p.Description, &p.ImageURL, &p.Link); err != nil {
+		if err := scanPortfolio(rows, &p); err != nil {
 			return nil, err
 		}
 		portfolios = append(portfolios, p)
@@ -40,24 +52,38 @@ func (r *portfolioRepository) GetAll(ctx context.Context) ([]model.Portfolio, er
 }
 
 func (r *portfolioRepository) GetByID(ctx context.Context, id int) (*model.Portfolio, error) {
+	defer metrics.ObserveQuery("portfolio", "GetByID", time.Now())
 	var p model.Portfolio
-	err := r.db.QueryRowContext(ctx, "SELECT id, name, description, image_url, link FROM portfolios WHERE id=$1", id).Scan(&p.ID, &p.Name, &p.Description, &p.ImageURL, &p.Link)
-	if err != nil {
+	if err := scanPortfolio(r.db.QueryRowContext(ctx, "SELECT "+portfolioColumns+" FROM portfolios WHERE id=$1", id), &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *portfolioRepository) GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error) {
+	defer metrics.ObserveQuery("portfolio", "GetBySlug", time.Now())
+	var p model.Portfolio
+	if err := scanPortfolio(r.db.QueryRowContext(ctx, "SELECT "+portfolioColumns+" FROM portfolios WHERE slug=$1", slug), &p); err != nil {
 		return nil, err
 	}
 	return &p, nil
 }
 
 func (r *portfolioRepository) Create(ctx context.Context, p *model.Portfolio) error {
-	return r.db.QueryRowContext(ctx, "INSERT INTO portfolios (name, description, image_url, link) VALUES ($1, $2, $3, $4) RETURNING id", p.Name, p.Description, p.ImageURL, p.Link).Scan(&p.ID)
+	defer metrics.ObserveQuery("portfolio", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO portfolios (name, description, image_url, link, slug, tags, translations) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '{}'::jsonb)) RETURNING id, created_at, updated_at",
+		p.Name, p.Description, p.ImageURL, p.Link, p.Slug, pq.Array(p.Tags), p.Translations).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
 }
 
 func (r *portfolioRepository) Update(ctx context.Context, p *model.Portfolio) error {
-	_, err := r.db.ExecContext(ctx, "UPDATE portfolios SET name=$1, description=$2, image_url=$3, link=$4 WHERE id=$5", p.Name, p.Description, p.ImageURL, p.Link, p.ID)
-	return err
+	defer metrics.ObserveQuery("portfolio", "Update", time.Now())
+	// Nil translations keep the stored ones; see model.Translations.
+	return r.db.QueryRowContext(ctx, "UPDATE portfolios SET name=$1, description=$2, image_url=$3, link=$4, slug=$5, tags=$6, translations=COALESCE($7, translations), updated_at=NOW() WHERE id=$8 RETURNING created_at, updated_at",
+		p.Name, p.Description, p.ImageURL, p.Link, p.Slug, pq.Array(p.Tags), p.Translations, p.ID).Scan(&p.CreatedAt, &p.UpdatedAt)
 }
 
 func (r *portfolioRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("portfolio", "Delete", time.Now())
 	_, err := r.db.ExecContext(ctx, "DELETE FROM portfolios WHERE id=$1", id)
 	return err
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"regexp"
 	"testing"
+	"time"
 
 	"porto/model"
 
@@ -17,17 +18,17 @@ func TestPortfolioRepository_GetAll(t *testing.T) {
 	repo := NewPortfolioRepository(db)
 
 	// success
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "image_url", "link"}).
-		AddRow(1, "A", "desc", "img", "link")
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link FROM portfolios")).
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "image_url", "link", "slug", "tags", "created_at", "updated_at", "translations"}).
+		AddRow(1, "A", "desc", "img", "link", "a", "{go,web}", time.Now(), time.Now(), `{"id": {"name": "B"}}`)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link, slug, tags, created_at, updated_at, translations FROM portfolios")).
 		WillReturnRows(rows)
 	result, err := repo.GetAll(context.Background())
-	if err != nil || len(result) != 1 {
-		t.Errorf("expected 1 result, got %v, err %v", result, err)
+	if err != nil || len(result) != 1 || result[0].Translations["id"].Name != "B" {
+		t.Errorf("expected 1 result with a translation, got %v, err %v", result, err)
 	}
 
 	// error
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link FROM portfolios")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link, slug, tags, created_at, updated_at, translations FROM portfolios")).
 		WillReturnError(sql.ErrConnDone)
 	_, err = repo.GetAll(context.Background())
 	if err == nil {
@@ -41,16 +42,16 @@ func TestPortfolioRepository_GetByID(t *testing.T) {
 	repo := NewPortfolioRepository(db)
 
 	// success
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link FROM portfolios WHERE id=$1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link, slug, tags, created_at, updated_at, translations FROM portfolios WHERE id=$1")).
 		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "image_url", "link"}).AddRow(1, "A", "desc", "img", "link"))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "image_url", "link", "slug", "tags", "created_at", "updated_at", "translations"}).AddRow(1, "A", "desc", "img", "link", "a", "{}", time.Now(), time.Now(), "{}"))
 	_, err := repo.GetByID(context.Background(), 1)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
 
 	// error
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link FROM portfolios WHERE id=$1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link, slug, tags, created_at, updated_at, translations FROM portfolios WHERE id=$1")).
 		WithArgs(2).
 		WillReturnError(sql.ErrNoRows)
 	_, err = repo.GetByID(context.Background(), 2)
@@ -59,26 +60,41 @@ func TestPortfolioRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestPortfolioRepository_GetBySlug(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewPortfolioRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, description, image_url, link, slug, tags, created_at, updated_at, translations FROM portfolios WHERE slug=$1")).
+		WithArgs("company-site").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "image_url", "link", "slug", "tags", "created_at", "updated_at", "translations"}).
+			AddRow(3, "Company site", "desc", "img", "link", "company-site", "{go,web}", time.Now(), time.Now(), "{}"))
+	p, err := repo.GetBySlug(context.Background(), "company-site")
+	if err != nil || p.ID != 3 || len(p.Tags) != 2 {
+		t.Errorf("expected project 3 with 2 tags, got %+v, err %v", p, err)
+	}
+}
+
 func TestPortfolioRepository_Create(t *testing.T) {
 	db, mock, _ := sqlmock.New()
 	defer db.Close()
 	repo := NewPortfolioRepository(db)
 
 	// success
-	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO portfolios (name, description, image_url, link) VALUES ($1, $2, $3, $4) RETURNING id")).
-		WithArgs("A", "desc", "img", "link").
-		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
-	p := &model.Portfolio{Name: "A", Description: "desc", ImageURL: "img", Link: "link"}
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO portfolios (name, description, image_url, link, slug, tags, translations) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '{}'::jsonb)) RETURNING id, created_at, updated_at")).
+		WithArgs("A", "desc", "img", "link", "a", sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(1, time.Now(), time.Now()))
+	p := &model.Portfolio{Name: "A", Description: "desc", ImageURL: "img", Link: "link", Slug: "a"}
 	err := repo.Create(context.Background(), p)
 	if err != nil || p.ID != 1 {
 		t.Errorf("expected id 1, got %v, err %v", p.ID, err)
 	}
 
 	// error
-	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO portfolios (name, description, image_url, link) VALUES ($1, $2, $3, $4) RETURNING id")).
-		WithArgs("B", "desc", "img", "link").
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO portfolios (name, description, image_url, link, slug, tags, translations) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, '{}'::jsonb)) RETURNING id, created_at, updated_at")).
+		WithArgs("B", "desc", "img", "link", "b", sqlmock.AnyArg(), nil).
 		WillReturnError(sql.ErrConnDone)
-	p2 := &model.Portfolio{Name: "B", Description: "desc", ImageURL: "img", Link: "link"}
+	p2 := &model.Portfolio{Name: "B", Description: "desc", ImageURL: "img", Link: "link", Slug: "b"}
 	err = repo.Create(context.Background(), p2)
 	if err == nil {
 		t.Error("expected error")
@@ -91,20 +107,21 @@ func TestPortfolioRepository_Update(t *testing.T) {
 	repo := NewPortfolioRepository(db)
 
 	// success
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE portfolios SET name=$1, description=$2, image_url=$3, link=$4 WHERE id=$5")).
-		WithArgs("A", "desc", "img", "link", 1).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-	p := &model.Portfolio{ID: 1, Name: "A", Description: "desc", ImageURL: "img", Link: "link"}
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE portfolios SET name=$1, description=$2, image_url=$3, link=$4, slug=$5, tags=$6, translations=COALESCE($7, translations), updated_at=NOW() WHERE id=$8 RETURNING created_at, updated_at")).
+		WithArgs("A", "desc", "img", "link", "a", sqlmock.AnyArg(), []byte(`{"id":{"name":"Situs"}}`), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(time.Now(), time.Now()))
+	p := &model.Portfolio{ID: 1, Name: "A", Description: "desc", ImageURL: "img", Link: "link", Slug: "a",
+		Translations: model.Translations[model.PortfolioText]{"id": {Name: "Situs"}}}
 	err := repo.Update(context.Background(), p)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
 
 	// error
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE portfolios SET name=$1, description=$2, image_url=$3, link=$4 WHERE id=$5")).
-		WithArgs("B", "desc", "img", "link", 2).
+	mock.ExpectQuery(regexp.QuoteMeta("UPDATE portfolios SET name=$1, description=$2, image_url=$3, link=$4, slug=$5, tags=$6, translations=COALESCE($7, translations), updated_at=NOW() WHERE id=$8 RETURNING created_at, updated_at")).
+		WithArgs("B", "desc", "img", "link", "b", sqlmock.AnyArg(), nil, 2).
 		WillReturnError(sql.ErrConnDone)
-	p2 := &model.Portfolio{ID: 2, Name: "B", Description: "desc", ImageURL: "img", Link: "link"}
+	p2 := &model.Portfolio{ID: 2, Name: "B", Description: "desc", ImageURL: "img", Link: "link", Slug: "b"}
 	err = repo.Update(context.Background(), p2)
 	if err == nil {
 		t.Error("expected error")
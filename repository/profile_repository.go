@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type ProfileRepository interface {
+	// Get returns sql.ErrNoRows until a profile has been saved.
+	Get(ctx context.Context) (*model.Profile, error)
+	// Save creates or replaces the profile.
+	Save(ctx context.Context, p *model.Profile) error
+}
+
+type profileRepository struct {
+	db *sql.DB
+}
+
+func NewProfileRepository(db *sql.DB) ProfileRepository {
+	return &profileRepository{db}
+}
+
+func (r *profileRepository) Get(ctx context.Context) (*model.Profile, error) {
+	defer metrics.ObserveQuery("profile", "Get", time.Now())
+	var p model.Profile
+	err := r.db.QueryRowContext(ctx, "SELECT name, headline, bio, avatar_url, location, phone, email, links, availability, updated_at, translations FROM profile").
+		Scan(&p.Name, &p.Headline, &p.Bio, &p.AvatarURL, &p.Location, &p.Phone, &p.Email, &p.Links, &p.Availability, &p.UpdatedAt, &p.Translations)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *profileRepository) Save(ctx context.Context, p *model.Profile) error {
+	defer metrics.ObserveQuery("profile", "Save", time.Now())
+	// Nil translations keep the stored ones; see model.Translations.
+	return r.db.QueryRowContext(ctx, "INSERT INTO profile (name, headline, bio, avatar_url, location, phone, email, links, availability, translations) "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, '{}'::jsonb)) "+
+		"ON CONFLICT (id) DO UPDATE SET name=EXCLUDED.name, headline=EXCLUDED.headline, bio=EXCLUDED.bio, avatar_url=EXCLUDED.avatar_url, "+
+		"location=EXCLUDED.location, phone=EXCLUDED.phone, email=EXCLUDED.email, links=EXCLUDED.links, availability=EXCLUDED.availability, "+
+		"translations=COALESCE($10, profile.translations), updated_at=NOW() "+
+		"RETURNING updated_at, translations",
+		p.Name, p.Headline, p.Bio, p.AvatarURL, p.Location, p.Phone, p.Email, p.Links, p.Availability, p.Translations).Scan(&p.UpdatedAt, &p.Translations)
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestProfileRepository_Get(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewProfileRepository(db)
+
+	// success
+	rows := sqlmock.NewRows([]string{"name", "headline", "bio", "avatar_url", "location", "phone", "email", "links", "availability", "updated_at", "translations"}).
+		AddRow("A", "Go developer", "bio", "img", "Jakarta", "+62", "a@b.test", `[{"network": "github", "url": "https://github.com/a"}]`, "busy", time.Now(), `{"id": {"bio": "biodata"}}`)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT name, headline, bio, avatar_url, location, phone, email, links, availability, updated_at, translations FROM profile")).
+		WillReturnRows(rows)
+	p, err := repo.Get(context.Background())
+	if err != nil || len(p.Links) != 1 || p.Links[0].Network != "github" || p.Translations["id"].Bio != "biodata" {
+		t.Errorf("expected profile with a link and a translation, got %+v, err %v", p, err)
+	}
+
+	// not saved yet
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT name, headline, bio, avatar_url, location, phone, email, links, availability, updated_at, translations FROM profile")).
+		WillReturnError(sql.ErrNoRows)
+	if _, err = repo.Get(context.Background()); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestProfileRepository_Save(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewProfileRepository(db)
+	query := regexp.QuoteMeta("INSERT INTO profile (name, headline, bio, avatar_url, location, phone, email, links, availability, translations) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, '{}'::jsonb)) ON CONFLICT (id) DO UPDATE SET")
+
+	// success; stored translations come back when none are sent
+	mock.ExpectQuery(query).
+		WithArgs("A", "", "", "", "", "", "", []byte("[]"), "available", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"updated_at", "translations"}).AddRow(time.Now(), `{"id": {"headline": "Pengembang"}}`))
+	p := &model.Profile{Name: "A", Availability: model.AvailabilityAvailable}
+	if err := repo.Save(context.Background(), p); err != nil || p.Translations["id"].Headline != "Pengembang" {
+		t.Errorf("expected stored translations, got %+v, err %v", p.Translations, err)
+	}
+
+	// error
+	mock.ExpectQuery(query).
+		WillReturnError(sql.ErrConnDone)
+	if err := repo.Save(context.Background(), &model.Profile{Name: "B"}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"porto/metrics"
+	"porto/model"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type ResumeRepository interface {
+	// Import upserts experiences and projects in one transaction. An
+	// experience matches an existing one with the same company, title and
+	// start date; a project matches by slug and keeps its image.
+	Import(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error)
+}
+
+type resumeRepository struct {
+	db *sql.DB
+}
+
+func NewResumeRepository(db *sql.DB) ResumeRepository {
+	return &resumeRepository{db}
+}
+
+func (r *resumeRepository) Import(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error) {
+	defer metrics.ObserveQuery("resume", "Import", time.Now())
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result model.ResumeImport
+	for i := range exps {
+		created, err := upsertExperience(ctx, tx, &exps[i])
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if created {
+			result.ExperiencesCreated++
+		} else {
+			result.ExperiencesUpdated++
+		}
+	}
+	for i := range projects {
+		p := &projects[i]
+		var created bool
+		// xmax is 0 only for rows inserted by this statement.
+		err := tx.QueryRowContext(ctx, "INSERT INTO portfolios (name, description, image_url, link, slug, tags) VALUES ($1, $2, $3, $4, $5, $6) "+
+			"ON CONFLICT (slug) DO UPDATE SET name=EXCLUDED.name, description=EXCLUDED.description, link=EXCLUDED.link, tags=EXCLUDED.tags, updated_at=NOW() "+
+			"RETURNING id, created_at, updated_at, xmax = 0",
+			p.Name, p.Description, p.ImageURL, p.Link, p.Slug, pq.Array(p.Tags)).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt, &created)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if created {
+			result.ProjectsCreated++
+		} else {
+			result.ProjectsUpdated++
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func upsertExperience(ctx context.Context, tx *sql.Tx, e *model.Experience) (bool, error) {
+	err := tx.QueryRowContext(ctx, "UPDATE experiences SET end_date=$1, is_current=$2, description=$3 WHERE company=$4 AND title=$5 AND start_date=$6 RETURNING id",
+		e.EndDate, e.IsCurrent, e.Description, e.Company, e.Title, e.StartDate).Scan(&e.ID)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	err = tx.QueryRowContext(ctx, "INSERT INTO experiences (title, company, start_date, end_date, is_current, description) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id",
+		e.Title, e.Company, e.StartDate, e.EndDate, e.IsCurrent, e.Description).Scan(&e.ID)
+	return err == nil, err
+}
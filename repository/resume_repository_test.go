@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestResumeRepository_Import(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewResumeRepository(db)
+	updateExp := regexp.QuoteMeta("UPDATE experiences SET end_date=$1, is_current=$2, description=$3 WHERE company=$4 AND title=$5 AND start_date=$6 RETURNING id")
+	insertExp := regexp.QuoteMeta("INSERT INTO experiences (title, company, start_date, end_date, is_current, description) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id")
+	upsertProject := regexp.QuoteMeta("INSERT INTO portfolios (name, description, image_url, link, slug, tags) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (slug) DO UPDATE")
+
+	exps := []model.Experience{
+		{Title: "Engineer", Company: "Acme", StartDate: model.NewDate(2022, time.March, 1), IsCurrent: true},
+		{Title: "Intern", Company: "Initech", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2020, time.June, 30)},
+	}
+	projects := []model.Portfolio{{Name: "Shop", Description: "d", Slug: "shop"}}
+
+	// success: one experience is new, the other and the project exist
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateExp).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(insertExp).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(updateExp).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(upsertProject).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "inserted"}).AddRow(5, time.Now(), time.Now(), false))
+	mock.ExpectCommit()
+	result, err := repo.Import(context.Background(), exps, projects)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := model.ResumeImport{ExperiencesCreated: 1, ExperiencesUpdated: 1, ProjectsUpdated: 1}
+	if *result != want {
+		t.Errorf("expected %+v, got %+v", want, *result)
+	}
+	if exps[0].ID != 7 || exps[1].ID != 3 || projects[0].ID != 5 {
+		t.Errorf("expected ids to be set, got %d, %d, %d", exps[0].ID, exps[1].ID, projects[0].ID)
+	}
+
+	// error rolls back
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateExp).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+	if _, err := repo.Import(context.Background(), exps, projects); err == nil {
+		t.Error("expected error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
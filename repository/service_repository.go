@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type ServiceRepository interface {
+	GetAll(ctx context.Context) ([]model.Service, error)
+	GetByID(ctx context.Context, id int) (*model.Service, error)
+	Create(ctx context.Context, s *model.Service) error
+	Update(ctx context.Context, s *model.Service) error
+	Delete(ctx context.Context, id int) error
+	// Reorder assigns sort orders 1..n following the given ids.
+	Reorder(ctx context.Context, ids []int) error
+}
+
+type serviceRepository struct {
+	db *sql.DB
+}
+
+func NewServiceRepository(db *sql.DB) ServiceRepository {
+	return &serviceRepository{db}
+}
+
+func (r *serviceRepository) GetAll(ctx context.Context) ([]model.Service, error) {
+	defer metrics.ObserveQuery("service", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT id, title, icon, description, sort_order FROM services ORDER BY sort_order, id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var services []model.Service
+	for rows.Next() {
+		var s model.Service
+		if err := rows.Scan(&s.ID, &s.Title, &s.Icon, &s.Description, &s.SortOrder); err != nil {
+			return nil, err
+		}
+		services = append(services, s)
+	}
+	return services, rows.Err()
+}
+
+func (r *serviceRepository) GetByID(ctx context.Context, id int) (*model.Service, error) {
+	defer metrics.ObserveQuery("service", "GetByID", time.Now())
+	var s model.Service
+	err := r.db.QueryRowContext(ctx, "SELECT id, title, icon, description, sort_order FROM services WHERE id=$1", id).Scan(&s.ID, &s.Title, &s.Icon, &s.Description, &s.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *serviceRepository) Create(ctx context.Context, s *model.Service) error {
+	defer metrics.ObserveQuery("service", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO services (title, icon, description, sort_order) VALUES ($1, $2, $3, $4) RETURNING id", s.Title, s.Icon, s.Description, s.SortOrder).Scan(&s.ID)
+}
+
+func (r *serviceRepository) Update(ctx context.Context, s *model.Service) error {
+	defer metrics.ObserveQuery("service", "Update", time.Now())
+	_, err := r.db.ExecContext(ctx, "UPDATE services SET title=$1, icon=$2, description=$3, sort_order=$4 WHERE id=$5", s.Title, s.Icon, s.Description, s.SortOrder, s.ID)
+	return err
+}
+
+func (r *serviceRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("service", "Delete", time.Now())
+	_, err := r.db.ExecContext(ctx, "DELETE FROM services WHERE id=$1", id)
+	return err
+}
+
+func (r *serviceRepository) Reorder(ctx context.Context, ids []int) error {
+	defer metrics.ObserveQuery("service", "Reorder", time.Now())
+	return reorder(ctx, r.db, "services", ids)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestServiceRepository_GetAll(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewServiceRepository(db)
+
+	// success
+	rows := sqlmock.NewRows([]string{"id", "title", "icon", "description", "sort_order"}).
+		AddRow(1, "Web design", "img/services/s3.png", "desc", 1)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, icon, description, sort_order FROM services ORDER BY sort_order, id")).
+		WillReturnRows(rows)
+	result, err := repo.GetAll(context.Background())
+	if err != nil || len(result) != 1 {
+		t.Errorf("expected 1 result, got %v, err %v", result, err)
+	}
+
+	// error
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, icon, description, sort_order FROM services ORDER BY sort_order, id")).
+		WillReturnError(sql.ErrConnDone)
+	_, err = repo.GetAll(context.Background())
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestServiceRepository_Create(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewServiceRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO services (title, icon, description, sort_order) VALUES ($1, $2, $3, $4) RETURNING id")).
+		WithArgs("Web design", "icon", "desc", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	s := &model.Service{Title: "Web design", Icon: "icon", Description: "desc", SortOrder: 2}
+	err := repo.Create(context.Background(), s)
+	if err != nil || s.ID != 5 {
+		t.Errorf("expected id 5, got %v, err %v", s.ID, err)
+	}
+}
+
+func TestServiceRepository_Reorder(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewServiceRepository(db)
+
+	// success
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE services SET sort_order=$1 WHERE id=$2")).
+		WithArgs(1, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE services SET sort_order=$1 WHERE id=$2")).
+		WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if err := repo.Reorder(context.Background(), []int{3, 1}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	// error rolls back
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE services SET sort_order=$1 WHERE id=$2")).
+		WithArgs(1, 3).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+	if err := repo.Reorder(context.Background(), []int{3, 1}); err == nil {
+		t.Error("expected error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
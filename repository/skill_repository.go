@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type SkillRepository interface {
+	GetAll(ctx context.Context) ([]model.Skill, error)
+	GetByID(ctx context.Context, id int) (*model.Skill, error)
+	Create(ctx context.Context, s *model.Skill) error
+	Update(ctx context.Context, s *model.Skill) error
+	Delete(ctx context.Context, id int) error
+	GetUsage(ctx context.Context) ([]model.SkillUsage, error)
+}
+
+type skillRepository struct {
+	db *sql.DB
+}
+
+func NewSkillRepository(db *sql.DB) SkillRepository {
+	return &skillRepository{db}
+}
+
+func (r *skillRepository) GetAll(ctx context.Context) ([]model.Skill, error) {
+	defer metrics.ObserveQuery("skill", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, category, proficiency, years FROM skills ORDER BY category, name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var skills []model.Skill
+	for rows.Next() {
+		var s model.Skill
+		if err := rows.Scan(&s.ID, &s.Name, &s.Category, &s.Proficiency, &s.Years); err != nil {
+			return nil, err
+		}
+		skills = append(skills, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadLinks(ctx, skills); err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+func (r *skillRepository) GetByID(ctx context.Context, id int) (*model.Skill, error) {
+	defer metrics.ObserveQuery("skill", "GetByID", time.Now())
+	var s model.Skill
+	err := r.db.QueryRowContext(ctx, "SELECT id, name, category, proficiency, years FROM skills WHERE id=$1", id).Scan(&s.ID, &s.Name, &s.Category, &s.Proficiency, &s.Years)
+	if err != nil {
+		return nil, err
+	}
+	skills := []model.Skill{s}
+	if err := r.loadLinks(ctx, skills); err != nil {
+		return nil, err
+	}
+	return &skills[0], nil
+}
+
+func (r *skillRepository) Create(ctx context.Context, s *model.Skill) error {
+	defer metrics.ObserveQuery("skill", "Create", time.Now())
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(ctx, "INSERT INTO skills (name, category, proficiency, years) VALUES ($1, $2, $3, $4) RETURNING id", s.Name, s.Category, s.Proficiency, s.Years).Scan(&s.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := replaceSkillLinks(ctx, tx, s); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *skillRepository) Update(ctx context.Context, s *model.Skill) error {
+	defer metrics.ObserveQuery("skill", "Update", time.Now())
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE skills SET name=$1, category=$2, proficiency=$3, years=$4 WHERE id=$5", s.Name, s.Category, s.Proficiency, s.Years, s.ID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := replaceSkillLinks(ctx, tx, s); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *skillRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("skill", "Delete", time.Now())
+	_, err := r.db.ExecContext(ctx, "DELETE FROM skills WHERE id=$1", id)
+	return err
+}
+
+// GetUsage returns every skill with the projects and experiences linked to it.
+func (r *skillRepository) GetUsage(ctx context.Context) ([]model.SkillUsage, error) {
+	defer metrics.ObserveQuery("skill", "GetUsage", time.Now())
+	skills, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage := make([]model.SkillUsage, len(skills))
+	index := make(map[int]*model.SkillUsage, len(skills))
+	for i, s := range skills {
+		usage[i].Skill = s
+		index[s.ID] = &usage[i]
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT ps.skill_id, p.id, p.name, p.description, p.image_url, p.link FROM portfolio_skills ps JOIN portfolios p ON p.id = ps.portfolio_id ORDER BY p.name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var skillID int
+		var p model.Portfolio
+		if err := rows.Scan(&skillID, &p.ID, &p.Name, &p.Description, &p.ImageURL, &p.Link); err != nil {
+			return nil, err
+		}
+		if u, ok := index[skillID]; ok {
+			u.Projects = append(u.Projects, p)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	expRows, err := r.db.QueryContext(ctx, "SELECT es.skill_id, e.id, e.title, e.company, e.start_date, e.end_date, e.is_current FROM experience_skills es JOIN experiences e ON e.id = es.experience_id ORDER BY e.start_date DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer expRows.Close()
+	for expRows.Next() {
+		var skillID int
+		var e model.Experience
+		if err := expRows.Scan(&skillID, &e.ID, &e.Title, &e.Company, &e.StartDate, &e.EndDate, &e.IsCurrent); err != nil {
+			return nil, err
+		}
+		if u, ok := index[skillID]; ok {
+			u.Experiences = append(u.Experiences, e)
+		}
+	}
+	return usage, expRows.Err()
+}
+
+func (r *skillRepository) loadLinks(ctx context.Context, skills []model.Skill) error {
+	if len(skills) == 0 {
+		return nil
+	}
+	index := make(map[int]*model.Skill, len(skills))
+	for i := range skills {
+		index[skills[i].ID] = &skills[i]
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT skill_id, portfolio_id FROM portfolio_skills")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var skillID, portfolioID int
+		if err := rows.Scan(&skillID, &portfolioID); err != nil {
+			return err
+		}
+		if s, ok := index[skillID]; ok {
+			s.PortfolioIDs = append(s.PortfolioIDs, portfolioID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	expRows, err := r.db.QueryContext(ctx, "SELECT skill_id, experience_id FROM experience_skills")
+	if err != nil {
+		return err
+	}
+	defer expRows.Close()
+	for expRows.Next() {
+		var skillID, experienceID int
+		if err := expRows.Scan(&skillID, &experienceID); err != nil {
+			return err
+		}
+		if s, ok := index[skillID]; ok {
+			s.ExperienceIDs = append(s.ExperienceIDs, experienceID)
+		}
+	}
+	return expRows.Err()
+}
+
+func replaceSkillLinks(ctx context.Context, tx *sql.Tx, s *model.Skill) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM portfolio_skills WHERE skill_id=$1", s.ID); err != nil {
+		return err
+	}
+	for _, pid := range s.PortfolioIDs {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO portfolio_skills (portfolio_id, skill_id) VALUES ($1, $2)", pid, s.ID); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM experience_skills WHERE skill_id=$1", s.ID); err != nil {
+		return err
+	}
+	for _, eid := range s.ExperienceIDs {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO experience_skills (experience_id, skill_id) VALUES ($1, $2)", eid, s.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
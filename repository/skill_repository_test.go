@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSkillRepository_GetAll(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewSkillRepository(db)
+
+	// success
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, category, proficiency, years FROM skills ORDER BY category, name")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "category", "proficiency", "years"}).
+			AddRow(1, "Go", "backend", "expert", 5))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT skill_id, portfolio_id FROM portfolio_skills")).
+		WillReturnRows(sqlmock.NewRows([]string{"skill_id", "portfolio_id"}).AddRow(1, 10).AddRow(1, 11))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT skill_id, experience_id FROM experience_skills")).
+		WillReturnRows(sqlmock.NewRows([]string{"skill_id", "experience_id"}).AddRow(1, 20))
+	result, err := repo.GetAll(context.Background())
+	if err != nil || len(result) != 1 {
+		t.Fatalf("expected 1 result, got %v, err %v", result, err)
+	}
+	if len(result[0].PortfolioIDs) != 2 || len(result[0].ExperienceIDs) != 1 {
+		t.Errorf("expected links to be loaded, got %+v", result[0])
+	}
+
+	// error
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, category, proficiency, years FROM skills ORDER BY category, name")).
+		WillReturnError(sql.ErrConnDone)
+	_, err = repo.GetAll(context.Background())
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSkillRepository_Create(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewSkillRepository(db)
+
+	// success
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO skills (name, category, proficiency, years) VALUES ($1, $2, $3, $4) RETURNING id")).
+		WithArgs("Go", "backend", "expert", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM portfolio_skills WHERE skill_id=$1")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO portfolio_skills (portfolio_id, skill_id) VALUES ($1, $2)")).
+		WithArgs(10, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM experience_skills WHERE skill_id=$1")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	s := &model.Skill{Name: "Go", Category: "backend", Proficiency: "expert", Years: 5, PortfolioIDs: []int{10}}
+	err := repo.Create(context.Background(), s)
+	if err != nil || s.ID != 1 {
+		t.Errorf("expected id 1, got %v, err %v", s.ID, err)
+	}
+
+	// error rolls back
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO skills (name, category, proficiency, years) VALUES ($1, $2, $3, $4) RETURNING id")).
+		WithArgs("Go", "backend", "expert", 5).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+	err = repo.Create(context.Background(), &model.Skill{Name: "Go", Category: "backend", Proficiency: "expert", Years: 5})
+	if err == nil {
+		t.Error("expected error")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSkillRepository_GetUsage(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewSkillRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, category, proficiency, years FROM skills ORDER BY category, name")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "category", "proficiency", "years"}).
+			AddRow(1, "Go", "backend", "expert", 5).
+			AddRow(2, "CSS", "frontend", "advanced", 3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT skill_id, portfolio_id FROM portfolio_skills")).
+		WillReturnRows(sqlmock.NewRows([]string{"skill_id", "portfolio_id"}).AddRow(1, 10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT skill_id, experience_id FROM experience_skills")).
+		WillReturnRows(sqlmock.NewRows([]string{"skill_id", "experience_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT ps.skill_id, p.id, p.name, p.description, p.image_url, p.link FROM portfolio_skills ps")).
+		WillReturnRows(sqlmock.NewRows([]string{"skill_id", "id", "name", "description", "image_url", "link"}).
+			AddRow(1, 10, "Porto", "desc", "img", "link"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT es.skill_id, e.id, e.title, e.company, e.start_date, e.end_date, e.is_current FROM experience_skills es")).
+		WillReturnRows(sqlmock.NewRows([]string{"skill_id", "id", "title", "company", "start_date", "end_date", "is_current"}))
+
+	usage, err := repo.GetUsage(context.Background())
+	if err != nil || len(usage) != 2 {
+		t.Fatalf("expected 2 results, got %v, err %v", usage, err)
+	}
+	if len(usage[0].Projects) != 1 || usage[0].Projects[0].Name != "Porto" {
+		t.Errorf("expected Go to be used in Porto, got %+v", usage[0].Projects)
+	}
+	if len(usage[1].Projects) != 0 {
+		t.Errorf("expected CSS to have no projects, got %+v", usage[1].Projects)
+	}
+}
+
+func TestSkillRepository_Delete(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewSkillRepository(db)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM skills WHERE id=$1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	if err := repo.Delete(context.Background(), 1); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
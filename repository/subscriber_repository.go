@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type SubscriberRepository interface {
+	GetByStatus(ctx context.Context, status string) ([]model.Subscriber, error)
+	GetByEmail(ctx context.Context, email string) (*model.Subscriber, error)
+	GetByToken(ctx context.Context, token string) (*model.Subscriber, error)
+	Create(ctx context.Context, s *model.Subscriber) error
+	// UpdateStatus stores s.Status and s.ConfirmedAt.
+	UpdateStatus(ctx context.Context, s *model.Subscriber) error
+}
+
+type subscriberRepository struct {
+	db *sql.DB
+}
+
+func NewSubscriberRepository(db *sql.DB) SubscriberRepository {
+	return &subscriberRepository{db}
+}
+
+const subscriberColumns = "id, email, token, status, created_at, confirmed_at"
+
+func (r *subscriberRepository) GetByStatus(ctx context.Context, status string) ([]model.Subscriber, error) {
+	defer metrics.ObserveQuery("subscriber", "GetByStatus", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT "+subscriberColumns+" FROM subscribers WHERE status=$1 ORDER BY created_at", status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var subscribers []model.Subscriber
+	for rows.Next() {
+		var s model.Subscriber
+		if err := rows.Scan(&s.ID, &s.Email, &s.Token, &s.Status, &s.CreatedAt, &s.ConfirmedAt); err != nil {
+			return nil, err
+		}
+		subscribers = append(subscribers, s)
+	}
+	return subscribers, rows.Err()
+}
+
+func (r *subscriberRepository) GetByEmail(ctx context.Context, email string) (*model.Subscriber, error) {
+	defer metrics.ObserveQuery("subscriber", "GetByEmail", time.Now())
+	return r.getOne(ctx, "SELECT "+subscriberColumns+" FROM subscribers WHERE email=$1", email)
+}
+
+func (r *subscriberRepository) GetByToken(ctx context.Context, token string) (*model.Subscriber, error) {
+	defer metrics.ObserveQuery("subscriber", "GetByToken", time.Now())
+	return r.getOne(ctx, "SELECT "+subscriberColumns+" FROM subscribers WHERE token=$1", token)
+}
+
+func (r *subscriberRepository) getOne(ctx context.Context, query string, arg any) (*model.Subscriber, error) {
+	var s model.Subscriber
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(&s.ID, &s.Email, &s.Token, &s.Status, &s.CreatedAt, &s.ConfirmedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *subscriberRepository) Create(ctx context.Context, s *model.Subscriber) error {
+	defer metrics.ObserveQuery("subscriber", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO subscribers (email, token, status) VALUES ($1, $2, $3) RETURNING id, created_at", s.Email, s.Token, s.Status).Scan(&s.ID, &s.CreatedAt)
+}
+
+func (r *subscriberRepository) UpdateStatus(ctx context.Context, s *model.Subscriber) error {
+	defer metrics.ObserveQuery("subscriber", "UpdateStatus", time.Now())
+	_, err := r.db.ExecContext(ctx, "UPDATE subscribers SET status=$1, confirmed_at=$2 WHERE id=$3", s.Status, s.ConfirmedAt, s.ID)
+	return err
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSubscriberRepository_GetByToken(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewSubscriberRepository(db)
+
+	// success, not yet confirmed
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, email, token, status, created_at, confirmed_at FROM subscribers WHERE token=$1")).
+		WithArgs("tok").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "token", "status", "created_at", "confirmed_at"}).
+			AddRow(1, "a@mail.com", "tok", "pending", time.Now(), nil))
+	s, err := repo.GetByToken(context.Background(), "tok")
+	if err != nil || s.ID != 1 || s.ConfirmedAt != nil {
+		t.Errorf("expected pending subscriber 1, got %+v, err %v", s, err)
+	}
+
+	// not found
+	mock.ExpectQuery(regexp.QuoteMeta("FROM subscribers WHERE token=$1")).
+		WithArgs("nope").
+		WillReturnError(sql.ErrNoRows)
+	if _, err := repo.GetByToken(context.Background(), "nope"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestSubscriberRepository_GetByStatus(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewSubscriberRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM subscribers WHERE status=$1 ORDER BY created_at")).
+		WithArgs("confirmed").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "token", "status", "created_at", "confirmed_at"}).
+			AddRow(1, "a@mail.com", "tok", "confirmed", now, now))
+	result, err := repo.GetByStatus(context.Background(), "confirmed")
+	if err != nil || len(result) != 1 || result[0].ConfirmedAt == nil {
+		t.Errorf("expected 1 confirmed subscriber, got %+v, err %v", result, err)
+	}
+}
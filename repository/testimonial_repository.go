@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"porto/metrics"
+	"porto/model"
+	"time"
+)
+
+type TestimonialRepository interface {
+	GetAll(ctx context.Context) ([]model.Testimonial, error)
+	GetByStatus(ctx context.Context, status string) ([]model.Testimonial, error)
+	GetByID(ctx context.Context, id int) (*model.Testimonial, error)
+	Create(ctx context.Context, t *model.Testimonial) error
+	UpdateStatus(ctx context.Context, id int, status string) error
+	Delete(ctx context.Context, id int) error
+}
+
+type testimonialRepository struct {
+	db *sql.DB
+}
+
+func NewTestimonialRepository(db *sql.DB) TestimonialRepository {
+	return &testimonialRepository{db}
+}
+
+const testimonialColumns = "id, author, role, company, avatar_url, quote, rating, status, created_at"
+
+func (r *testimonialRepository) GetAll(ctx context.Context) ([]model.Testimonial, error) {
+	defer metrics.ObserveQuery("testimonial", "GetAll", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT "+testimonialColumns+" FROM testimonials ORDER BY created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	return scanTestimonials(rows)
+}
+
+func (r *testimonialRepository) GetByStatus(ctx context.Context, status string) ([]model.Testimonial, error) {
+	defer metrics.ObserveQuery("testimonial", "GetByStatus", time.Now())
+	rows, err := r.db.QueryContext(ctx, "SELECT "+testimonialColumns+" FROM testimonials WHERE status=$1 ORDER BY created_at DESC", status)
+	if err != nil {
+		return nil, err
+	}
+	return scanTestimonials(rows)
+}
+
+func (r *testimonialRepository) GetByID(ctx context.Context, id int) (*model.Testimonial, error) {
+	defer metrics.ObserveQuery("testimonial", "GetByID", time.Now())
+	var t model.Testimonial
+	err := r.db.QueryRowContext(ctx, "SELECT "+testimonialColumns+" FROM testimonials WHERE id=$1", id).
+		Scan(&t.ID, &t.Author, &t.Role, &t.Company, &t.AvatarURL, &t.Quote, &t.Rating, &t.Status, &t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *testimonialRepository) Create(ctx context.Context, t *model.Testimonial) error {
+	defer metrics.ObserveQuery("testimonial", "Create", time.Now())
+	return r.db.QueryRowContext(ctx, "INSERT INTO testimonials (author, role, company, avatar_url, quote, rating, status) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at",
+		t.Author, t.Role, t.Company, t.AvatarURL, t.Quote, t.Rating, t.Status).Scan(&t.ID, &t.CreatedAt)
+}
+
+// UpdateStatus returns sql.ErrNoRows when no testimonial has the given id.
+func (r *testimonialRepository) UpdateStatus(ctx context.Context, id int, status string) error {
+	defer metrics.ObserveQuery("testimonial", "UpdateStatus", time.Now())
+	res, err := r.db.ExecContext(ctx, "UPDATE testimonials SET status=$1 WHERE id=$2", status, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *testimonialRepository) Delete(ctx context.Context, id int) error {
+	defer metrics.ObserveQuery("testimonial", "Delete", time.Now())
+	_, err := r.db.ExecContext(ctx, "DELETE FROM testimonials WHERE id=$1", id)
+	return err
+}
+
+func scanTestimonials(rows *sql.Rows) ([]model.Testimonial, error) {
+	defer rows.Close()
+	var testimonials []model.Testimonial
+	for rows.Next() {
+		var t model.Testimonial
+		if err := rows.Scan(&t.ID, &t.Author, &t.Role, &t.Company, &t.AvatarURL, &t.Quote, &t.Rating, &t.Status, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		testimonials = append(testimonials, t)
+	}
+	return testimonials, rows.Err()
+}
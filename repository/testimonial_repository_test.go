@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"porto/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var testimonialRowColumns = []string{"id", "author", "role", "company", "avatar_url", "quote", "rating", "status", "created_at"}
+
+func TestTestimonialRepository_GetByStatus(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewTestimonialRepository(db)
+
+	// success
+	mock.ExpectQuery(regexp.QuoteMeta("FROM testimonials WHERE status=$1 ORDER BY created_at DESC")).
+		WithArgs("approved").
+		WillReturnRows(sqlmock.NewRows(testimonialRowColumns).
+			AddRow(1, "Elite Martin", "CTO", "Acme", "", "Great work", 5, "approved", time.Now()))
+	result, err := repo.GetByStatus(context.Background(), "approved")
+	if err != nil || len(result) != 1 {
+		t.Errorf("expected 1 result, got %v, err %v", result, err)
+	}
+
+	// error
+	mock.ExpectQuery(regexp.QuoteMeta("FROM testimonials WHERE status=$1")).
+		WithArgs("approved").
+		WillReturnError(sql.ErrConnDone)
+	_, err = repo.GetByStatus(context.Background(), "approved")
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTestimonialRepository_Create(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewTestimonialRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO testimonials (author, role, company, avatar_url, quote, rating, status) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at")).
+		WithArgs("A", "CTO", "Acme", "", "Great", 5, "pending").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, now))
+	tm := &model.Testimonial{Author: "A", Role: "CTO", Company: "Acme", Quote: "Great", Rating: 5, Status: "pending"}
+	err := repo.Create(context.Background(), tm)
+	if err != nil || tm.ID != 1 || !tm.CreatedAt.Equal(now) {
+		t.Errorf("expected id 1 and created_at set, got %+v, err %v", tm, err)
+	}
+}
+
+func TestTestimonialRepository_UpdateStatus(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+	repo := NewTestimonialRepository(db)
+
+	// success
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE testimonials SET status=$1 WHERE id=$2")).
+		WithArgs("approved", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	if err := repo.UpdateStatus(context.Background(), 1, "approved"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	// not found
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE testimonials SET status=$1 WHERE id=$2")).
+		WithArgs("approved", 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	if err := repo.UpdateStatus(context.Background(), 2, "approved"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"porto/handler"
+	"porto/health"
+	"porto/i18n"
+	"porto/metrics"
+	"porto/middleware"
+	"porto/openapi"
+	"porto/tracing"
+)
+
+// handlers are the endpoints newRouter dispatches to.
+type handlers struct {
+	portfolio   *handler.PortfolioHandler
+	experience  *handler.ExperienceHandler
+	contact     *handler.ContactHandler
+	skill       *handler.SkillHandler
+	service     *handler.ServiceHandler
+	testimonial *handler.TestimonialHandler
+	media       *handler.MediaHandler
+	client      *handler.ClientHandler
+	subscriber  *handler.SubscriberHandler
+	job         *handler.JobHandler
+	resume      *handler.ResumeHandler
+	feed        *handler.FeedHandler
+	seo         *handler.SEOHandler
+	profile     *handler.ProfileHandler
+	home        *handler.HomeHandler
+	health      *health.Checker
+	graphql     http.Handler
+}
+
+// newRouter registers every route and the middleware in front of them.
+// adminToken is the bearer token the admin routes require.
+func newRouter(h handlers, trustedProxies, adminToken string) *chi.Mux {
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(tracing.Middleware)
+
+	// Throttle per client IP: lenient everywhere, strict on public form
+	// submissions. trustedProxies lists the proxies allowed to set
+	// X-Forwarded-For.
+	limits := middleware.NewMemoryStore()
+	strict := middleware.RateLimit(limits, "submit", middleware.PerMinute(5), middleware.KeyByIP)
+	r.Use(middleware.RealIP(middleware.ParseTrustedProxies(trustedProxies)))
+	r.Use(middleware.AccessLog)
+	r.Use(metrics.Middleware)
+	r.Use(middleware.RateLimit(limits, "default", middleware.PerMinute(300), middleware.KeyByIP))
+	// Locale from a "/id" or "/en" prefix, the lang cookie or Accept-Language
+	r.Use(i18n.Middleware)
+	admin := middleware.Admin(adminToken)
+
+	// Prometheus scrape endpoint
+	r.Handle("/metrics", promhttp.Handler())
+
+	// Liveness and readiness probes
+	r.Get("/healthz", h.health.Healthz)
+	r.Get("/readyz", h.health.Readyz)
+
+	r.Route("/api", func(r chi.Router) {
+		// API description and docs UI
+		r.Method(http.MethodGet, "/openapi.json", openapi.Handler(openapi.Routes))
+		r.Method(http.MethodGet, "/docs", openapi.Docs("/api/openapi.json"))
+
+		// JSON Resume export and import; the format is versioned by its schema
+		r.Get("/resume.json", h.resume.GetResume)
+		r.With(admin).Post("/resume/import", h.resume.ImportResume)
+
+		r.Route("/v1", func(r chi.Router) { h.apiV1(r, strict, admin) })
+		r.Route("/v2", func(r chi.Router) { h.apiV2(r) })
+
+		// The unversioned paths are v1 aliases kept for existing clients.
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Deprecated(unversioned))
+			h.apiV1(r, strict, admin)
+		})
+	})
+
+	// GraphQL over the same services as the JSON API
+	r.Method(http.MethodPost, "/graphql", h.graphql)
+
+	// PDF CV
+	r.Get("/cv.pdf", h.resume.GetCV)
+
+	// Atom and RSS feeds of recent projects
+	r.Get("/feed.xml", h.feed.GetAtom)
+	r.Get("/rss.xml", h.feed.GetRSS)
+
+	// Crawler endpoints
+	r.Get("/sitemap.xml", h.seo.GetSitemap)
+	r.Get("/robots.txt", h.seo.GetRobots)
+
+	// Static file serving (optional)
+	r.Handle("/static/*", http.StripPrefix("/static/", http.FileServer(http.Dir("webview/static"))))
+	r.Handle("/uploads/*", http.StripPrefix("/uploads/", http.FileServer(http.Dir("uploads"))))
+
+	// HTML template routes; form posts need the CSRF token
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.CSRF)
+		r.Get("/", h.home.RenderHomePage)
+		r.Get("/portfolio", h.portfolio.RenderPortfolioPage)
+		r.Get("/portfolio/{slug}", h.portfolio.RenderProjectPage)
+		r.Get("/about", h.portfolio.RenderAboutPage)
+		r.Get("/services", h.service.RenderServicesPage)
+		r.Get("/contact", h.contact.RenderContactPage)
+		r.With(strict).Post("/contact", h.contact.RenderContactPage)
+		r.With(strict).Post("/newsletter/subscribe", h.subscriber.Subscribe)
+		r.Get("/newsletter/confirm", h.subscriber.Confirm)
+		r.Post("/newsletter/confirm", h.subscriber.Confirm)
+		r.Get("/newsletter/unsubscribe", h.subscriber.Unsubscribe)
+		r.Post("/newsletter/unsubscribe", h.subscriber.Unsubscribe)
+	})
+	return r
+}
+
+// unversioned is the deprecation policy of the /api/... aliases of /api/v1.
+var unversioned = middleware.Deprecation{
+	Since:     time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+	Sunset:    time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC),
+	Prefix:    "/api",
+	Successor: "/api/v1",
+}
+
+// apiV1 registers the v1 JSON API relative to its mount point. strict is the
+// rate limit for public submissions and admin guards the owner's routes.
+func (h handlers) apiV1(r chi.Router, strict, admin func(http.Handler) http.Handler) {
+	// Portfolio endpoints
+	r.Get("/projects", h.portfolio.GetProjects)
+	r.Post("/projects", h.portfolio.CreateProject)
+	r.Put("/projects", h.portfolio.UpdateProject)
+	r.Delete("/projects/{id}", h.portfolio.DeleteProject)
+
+	// Experience endpoints
+	r.Get("/experiences", h.experience.GetExperiences)
+	r.Post("/experiences", h.experience.CreateExperience)
+	r.Put("/experiences", h.experience.UpdateExperience)
+	r.Delete("/experiences/{id}", h.experience.DeleteExperience)
+
+	// Skill endpoints
+	r.Get("/skills", h.skill.GetSkills)
+	r.Get("/skills/usage", h.skill.GetSkillUsage)
+	r.Post("/skills", h.skill.CreateSkill)
+	r.Put("/skills", h.skill.UpdateSkill)
+	r.Delete("/skills/{id}", h.skill.DeleteSkill)
+
+	// Service endpoints
+	r.Get("/services", h.service.GetServices)
+	r.Post("/services", h.service.CreateService)
+	r.Put("/services", h.service.UpdateService)
+	r.Put("/services/order", h.service.ReorderServices)
+	r.Delete("/services/{id}", h.service.DeleteService)
+
+	// Testimonial endpoints
+	r.Get("/testimonials", h.testimonial.GetTestimonials)
+	r.With(strict).Post("/testimonials", h.testimonial.SubmitTestimonial)
+
+	// Media endpoints
+	r.Get("/media", h.media.GetMedia)
+	r.Post("/media", h.media.UploadMedia)
+	r.Delete("/media/{id}", h.media.DeleteMedia)
+
+	// Client endpoints
+	r.Get("/clients", h.client.GetClients)
+	r.Post("/clients", h.client.CreateClient)
+	r.Put("/clients", h.client.UpdateClient)
+	r.Put("/clients/order", h.client.ReorderClients)
+	r.Delete("/clients/{id}", h.client.DeleteClient)
+
+	// Newsletter endpoints
+	r.With(strict).Post("/newsletter/subscribe", h.subscriber.Subscribe)
+
+	// Owner profile endpoints
+	r.Get("/profile", h.profile.GetProfile)
+
+	// Contact endpoints
+	r.Get("/contacts/form-token", h.contact.GetContactFormToken)
+	r.With(strict).Post("/contacts", h.contact.CreateContact)
+
+	// Admin endpoints, which need the admin bearer token
+	r.Group(func(r chi.Router) {
+		r.Use(admin)
+
+		// Owner profile
+		r.Put("/profile", h.profile.UpdateProfile)
+
+		// Newsletter subscribers
+		r.Get("/admin/subscribers", h.subscriber.GetSubscribers)
+		r.Get("/admin/subscribers/export", h.subscriber.ExportSubscribers)
+
+		// Testimonial moderation
+		r.Get("/admin/testimonials", h.testimonial.GetModerationQueue)
+		r.Post("/admin/testimonials/{id}/approve", h.testimonial.ApproveTestimonial)
+		r.Post("/admin/testimonials/{id}/reject", h.testimonial.RejectTestimonial)
+		r.Delete("/admin/testimonials/{id}", h.testimonial.DeleteTestimonial)
+
+		// Inbox
+		r.Get("/contacts", h.contact.GetContacts)
+		r.Get("/contacts/counts", h.contact.GetContactCounts)
+		r.Put("/contacts/status", h.contact.BulkUpdateContactStatus)
+		r.Get("/contacts/{id}", h.contact.GetContact)
+		r.Put("/contacts/{id}/status", h.contact.UpdateContactStatus)
+		r.Put("/contacts/{id}/notes", h.contact.UpdateContactNotes)
+		r.Delete("/contacts/{id}", h.contact.DeleteContact)
+
+		// Job queue
+		r.Get("/admin/jobs", h.job.GetJobs)
+		r.Post("/admin/jobs/{id}/retry", h.job.RetryJob)
+	})
+}
+
+// apiV2 registers the resources whose shape changed in v2. Projects are
+// addressed by slug.
+func (h handlers) apiV2(r chi.Router) {
+	r.Get("/projects", h.portfolio.GetProjectsV2)
+	r.Post("/projects", h.portfolio.CreateProjectV2)
+	r.Get("/projects/{slug}", h.portfolio.GetProjectV2)
+	r.Put("/projects/{slug}", h.portfolio.UpdateProjectV2)
+	r.Delete("/projects/{slug}", h.portfolio.DeleteProjectV2)
+
+	r.Get("/experiences", h.experience.GetExperiencesV2)
+	r.Post("/experiences", h.experience.CreateExperienceV2)
+	r.Put("/experiences", h.experience.UpdateExperienceV2)
+	r.Delete("/experiences/{id}", h.experience.DeleteExperience)
+}
@@ -0,0 +1,217 @@
+// Package seo builds the search and share metadata of the public pages:
+// head tags, schema.org structured data, the sitemap and robots.txt.
+package seo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"porto/i18n"
+	"porto/model"
+)
+
+// Site describes the public site. BaseURL, e.g. "https://example.com", makes
+// canonical and share URLs absolute.
+type Site struct {
+	// Name is appended to page titles and used as og:site_name.
+	Name        string
+	BaseURL     string
+	Description string
+	// Image is the default share image, e.g. "/img/banner.png".
+	Image string
+	// Twitter is the site's handle for Twitter cards, e.g. "@example".
+	Twitter string
+	Person  Person
+	// Locale prefixes canonical URLs, e.g. "id" makes "/about" "/id/about".
+	Locale string
+}
+
+// Person is the site owner as described in the JSON-LD Person.
+type Person struct {
+	Name     string
+	JobTitle string
+	Email    string
+	Image    string
+	// SameAs lists profile URLs elsewhere, e.g. GitHub or LinkedIn.
+	SameAs []string
+}
+
+// Meta is the head data of one page. Every URL is absolute.
+type Meta struct {
+	Title       string
+	Description string
+	Canonical   string
+	Image       string
+	// Type is the og:type, e.g. "website", "profile" or "article".
+	Type     string
+	SiteName string
+	Twitter  string
+	// JSONLD holds schema.org objects rendered as application/ld+json.
+	JSONLD []any
+	// Alternates are the translations of the page, linked with hreflang.
+	Alternates []Alternate
+}
+
+// Alternate is the URL of a page in one language. Lang "x-default" is the
+// unprefixed URL, whose language is negotiated.
+type Alternate struct {
+	Lang string
+	URL  string
+}
+
+// LocalePath prefixes path with locale, e.g. "/id/about" or "/id" for the
+// home page. An empty locale leaves path unchanged.
+func LocalePath(locale, path string) string {
+	switch {
+	case locale == "":
+		return path
+	case path == "/":
+		return "/" + locale
+	}
+	return "/" + locale + path
+}
+
+// In returns s with canonical URLs in locale.
+func (s Site) In(locale string) Site {
+	s.Locale = locale
+	return s
+}
+
+// descriptionLength is where search engines typically cut descriptions.
+const descriptionLength = 160
+
+// URL makes a site path absolute. Absolute URLs are returned unchanged.
+func (s Site) URL(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return strings.TrimRight(s.BaseURL, "/") + path
+}
+
+// Page returns the metadata of the page at path, with a link to each
+// translation. An empty description falls back to the site description.
+func (s Site) Page(path, title, description string) Meta {
+	if description == "" {
+		description = s.Description
+	}
+	m := Meta{
+		Title:       title,
+		Description: truncate(description, descriptionLength),
+		Canonical:   s.URL(LocalePath(s.Locale, path)),
+		Type:        "website",
+		SiteName:    s.Name,
+		Twitter:     s.Twitter,
+	}
+	for _, locale := range i18n.Supported {
+		m.Alternates = append(m.Alternates, Alternate{Lang: locale, URL: s.URL(LocalePath(locale, path))})
+	}
+	m.Alternates = append(m.Alternates, Alternate{Lang: "x-default", URL: s.URL(path)})
+	if s.Name != "" && title != s.Name {
+		m.Title = title + " | " + s.Name
+	}
+	if s.Image != "" {
+		m.Image = s.URL(s.Image)
+	}
+	return m
+}
+
+// ProjectPath is the detail page of a project.
+func ProjectPath(p model.Portfolio) string {
+	return "/portfolio/" + p.Slug
+}
+
+// Project returns the metadata of a project's detail page, with the
+// project as a CreativeWork.
+func (s Site) Project(p model.Portfolio) Meta {
+	m := s.Page(ProjectPath(p), p.Name, p.Description)
+	m.Type = "article"
+	if p.ImageURL != "" {
+		m.Image = s.URL(p.ImageURL)
+	}
+	m.JSONLD = []any{s.CreativeWork(p)}
+	return m
+}
+
+// WithProfile returns s with the owner taken from p. A profile without a
+// name leaves s unchanged.
+func (s Site) WithProfile(p model.Profile) Site {
+	if p.Name == "" {
+		return s
+	}
+	s.Person = Person{Name: p.Name, JobTitle: p.Headline, Email: p.Email, Image: p.AvatarURL}
+	for _, l := range p.Links {
+		s.Person.SameAs = append(s.Person.SameAs, l.URL)
+	}
+	if s.Description == "" {
+		s.Description = p.Bio
+	}
+	return s
+}
+
+// PersonLD is the site owner as a schema.org Person.
+func (s Site) PersonLD() map[string]any {
+	ld := map[string]any{
+		"@context": "https://schema.org",
+		"@type":    "Person",
+		"name":     s.Person.Name,
+		"url":      s.URL("/"),
+	}
+	setIf(ld, "jobTitle", s.Person.JobTitle)
+	setIf(ld, "email", s.Person.Email)
+	if s.Person.Image != "" {
+		ld["image"] = s.URL(s.Person.Image)
+	}
+	if len(s.Person.SameAs) > 0 {
+		ld["sameAs"] = s.Person.SameAs
+	}
+	return ld
+}
+
+// CreativeWork describes a project as a schema.org CreativeWork.
+func (s Site) CreativeWork(p model.Portfolio) map[string]any {
+	ld := map[string]any{
+		"@context":    "https://schema.org",
+		"@type":       "CreativeWork",
+		"name":        p.Name,
+		"description": p.Description,
+		"url":         s.URL(ProjectPath(p)),
+	}
+	if p.ImageURL != "" {
+		ld["image"] = s.URL(p.ImageURL)
+	}
+	if len(p.Tags) > 0 {
+		ld["keywords"] = strings.Join(p.Tags, ", ")
+	}
+	if !p.CreatedAt.IsZero() {
+		ld["dateCreated"] = p.CreatedAt.UTC().Format("2006-01-02")
+	}
+	if !p.UpdatedAt.IsZero() {
+		ld["dateModified"] = p.UpdatedAt.UTC().Format("2006-01-02")
+	}
+	if s.Person.Name != "" {
+		ld["creator"] = map[string]any{"@type": "Person", "name": s.Person.Name}
+	}
+	return ld
+}
+
+func setIf(m map[string]any, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
+// truncate shortens s to at most n runes on a word boundary.
+func truncate(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	cut := string([]rune(s)[:n-1])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
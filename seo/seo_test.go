@@ -0,0 +1,124 @@
+package seo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"porto/i18n"
+	"porto/model"
+)
+
+var site = Site{
+	Name:        "Ann",
+	BaseURL:     "https://example.com/",
+	Description: "Go developer",
+	Image:       "/img/banner.png",
+	Person:      Person{Name: "Ann", JobTitle: "Developer", SameAs: []string{"https://github.com/ann"}},
+}
+
+func TestPage(t *testing.T) {
+	m := site.Page("/about", "About", "")
+	if m.Title != "About | Ann" || m.Canonical != "https://example.com/about" {
+		t.Errorf("unexpected title or canonical: %+v", m)
+	}
+	if m.Description != "Go developer" || m.Image != "https://example.com/img/banner.png" {
+		t.Errorf("expected the site defaults, got %+v", m)
+	}
+	if home := site.Page("/", "Ann", ""); home.Title != "Ann" {
+		t.Errorf("expected the home title without a suffix, got %q", home.Title)
+	}
+
+	m = site.In("id").Page("/about", "Tentang", "")
+	if m.Canonical != "https://example.com/id/about" {
+		t.Errorf("expected the canonical URL to keep the locale, got %q", m.Canonical)
+	}
+	want := []Alternate{
+		{"en", "https://example.com/en/about"},
+		{"id", "https://example.com/id/about"},
+		{"x-default", "https://example.com/about"},
+	}
+	if !slices.Equal(m.Alternates, want) {
+		t.Errorf("expected alternates %v, got %v", want, m.Alternates)
+	}
+	if home := site.In("id").Page("/", "Ann", ""); home.Canonical != "https://example.com/id" {
+		t.Errorf("unexpected home canonical %q", home.Canonical)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	got := truncate(strings.Repeat("word ", 50), descriptionLength)
+	if len([]rune(got)) > descriptionLength || !strings.HasSuffix(got, "word…") {
+		t.Errorf("expected a cut on a word boundary, got %q", got)
+	}
+	if got := truncate("  short\n text ", descriptionLength); got != "short text" {
+		t.Errorf("expected whitespace to be collapsed, got %q", got)
+	}
+}
+
+func TestProject(t *testing.T) {
+	p := model.Portfolio{Name: "Shop", Slug: "shop", Description: "A shop", ImageURL: "/uploads/shop.png", Tags: []string{"go", "sql"},
+		CreatedAt: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)}
+	m := site.Project(p)
+	if m.Type != "article" || m.Image != "https://example.com/uploads/shop.png" {
+		t.Errorf("unexpected meta: %+v", m)
+	}
+	b, err := json.Marshal(m.JSONLD[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"@type":"CreativeWork"`, `"url":"https://example.com/portfolio/shop"`, `"keywords":"go, sql"`, `"dateCreated":"2025-03-04"`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("expected %s in %s", want, b)
+		}
+	}
+}
+
+func TestWithProfile(t *testing.T) {
+	if got := site.WithProfile(model.Profile{}); got.Person.Name != site.Person.Name {
+		t.Errorf("expected an empty profile to keep the site, got %+v", got.Person)
+	}
+	p := model.Profile{Name: "Jane", Headline: "Go developer", Links: model.SocialLinks{{Network: "github", URL: "https://github.com/jane"}}}
+	got := site.WithProfile(p)
+	if got.Person.Name != "Jane" || got.Person.JobTitle != "Go developer" || len(got.Person.SameAs) != 1 {
+		t.Errorf("expected the person from the profile, got %+v", got.Person)
+	}
+}
+
+func TestSitemap(t *testing.T) {
+	updated := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	b, err := Sitemap(site, []model.Portfolio{{Slug: "shop", UpdatedAt: updated}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var set urlset
+	if err := xml.Unmarshal(b, &set); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, b)
+	}
+	if want := (len(Pages) + 1) * len(i18n.Supported); len(set.URLs) != want {
+		t.Fatalf("expected %d URLs, got %d", want, len(set.URLs))
+	}
+	if set.URLs[0].Loc != "https://example.com/en" || set.URLs[1].Loc != "https://example.com/id" {
+		t.Errorf("expected the home page in each locale, got %+v", set.URLs[:2])
+	}
+	last := set.URLs[len(set.URLs)-1]
+	if last.Loc != "https://example.com/id/portfolio/shop" || last.LastMod != "2025-05-01T00:00:00Z" {
+		t.Errorf("unexpected project entry: %+v", last)
+	}
+	if portfolio := set.URLs[2]; portfolio.Loc != "https://example.com/en/portfolio" || portfolio.LastMod != last.LastMod {
+		t.Errorf("expected the portfolio page to carry the latest update, got %+v", portfolio)
+	}
+}
+
+func TestRobotsTxt(t *testing.T) {
+	if got := string(RobotsTxt(site, Robots{NoIndex: true, Disallow: []string{"/api/"}})); !strings.HasPrefix(got, "User-agent: *\nDisallow: /\n") {
+		t.Errorf("expected the whole site to be blocked, got:\n%s", got)
+	}
+	got := string(RobotsTxt(site, Robots{}))
+	if !strings.Contains(got, "Disallow:\n") || !strings.HasSuffix(got, "Sitemap: https://example.com/sitemap.xml\n") {
+		t.Errorf("unexpected robots.txt:\n%s", got)
+	}
+}
@@ -0,0 +1,86 @@
+package seo
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"porto/i18n"
+	"porto/model"
+)
+
+// Pages are the public pages listed in the sitemap besides the projects.
+var Pages = []string{"/", "/portfolio", "/about", "/services", "/contact"}
+
+type urlset struct {
+	XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Sitemap lists Pages and every project detail page in each supported
+// locale. Projects carry their last update; the portfolio page carries the
+// latest of them.
+func Sitemap(s Site, projects []model.Portfolio) ([]byte, error) {
+	var latest time.Time
+	var set urlset
+	for _, p := range projects {
+		if p.UpdatedAt.After(latest) {
+			latest = p.UpdatedAt
+		}
+	}
+	add := func(path string, modified time.Time) {
+		for _, locale := range i18n.Supported {
+			u := sitemapURL{Loc: s.URL(LocalePath(locale, path))}
+			if !modified.IsZero() {
+				u.LastMod = modified.UTC().Format(time.RFC3339)
+			}
+			set.URLs = append(set.URLs, u)
+		}
+	}
+	for _, path := range Pages {
+		if path == "/portfolio" {
+			add(path, latest)
+		} else {
+			add(path, time.Time{})
+		}
+	}
+	for _, p := range projects {
+		add(ProjectPath(p), p.UpdatedAt)
+	}
+	b, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// Robots configures robots.txt.
+type Robots struct {
+	// Disallow lists path prefixes crawlers should skip, e.g. "/api/".
+	Disallow []string
+	// NoIndex blocks the whole site, e.g. on staging.
+	NoIndex bool
+}
+
+// RobotsTxt renders robots.txt with a link to the sitemap.
+func RobotsTxt(s Site, r Robots) []byte {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	switch {
+	case r.NoIndex:
+		b.WriteString("Disallow: /\n")
+	case len(r.Disallow) == 0:
+		b.WriteString("Disallow:\n")
+	default:
+		for _, path := range r.Disallow {
+			b.WriteString("Disallow: " + path + "\n")
+		}
+	}
+	b.WriteString("\nSitemap: " + s.URL("/sitemap.xml") + "\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"porto/model"
+	"porto/repository"
+	"porto/validation"
+)
+
+type ClientService interface {
+	GetAll(ctx context.Context) ([]model.Client, error)
+	GetByID(ctx context.Context, id int) (*model.Client, error)
+	Create(ctx context.Context, c *model.Client) error
+	Update(ctx context.Context, c *model.Client) error
+	Delete(ctx context.Context, id int) error
+	Reorder(ctx context.Context, ids []int) error
+}
+
+type clientService struct {
+	repo  repository.ClientRepository
+	media repository.MediaRepository
+}
+
+func NewClientService(repo repository.ClientRepository, media repository.MediaRepository) ClientService {
+	return &clientService{repo: repo, media: media}
+}
+
+func (s *clientService) GetAll(ctx context.Context) ([]model.Client, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *clientService) GetByID(ctx context.Context, id int) (*model.Client, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *clientService) Create(ctx context.Context, c *model.Client) error {
+	if err := s.validate(ctx, c); err != nil {
+		slog.WarnContext(ctx, "Create validation error", "component", "ClientService", "error", err)
+		return err
+	}
+	err := s.repo.Create(ctx, c)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create DB error", "component", "ClientService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Created client", "component", "ClientService", "id", c.ID)
+	return nil
+}
+
+func (s *clientService) Update(ctx context.Context, c *model.Client) error {
+	if c.ID == 0 {
+		slog.WarnContext(ctx, "Update error: id is required", "component", "ClientService")
+		return errors.New("id is required")
+	}
+	if err := s.validate(ctx, c); err != nil {
+		slog.WarnContext(ctx, "Update validation error", "component", "ClientService", "error", err)
+		return err
+	}
+	err := s.repo.Update(ctx, c)
+	if err != nil {
+		slog.ErrorContext(ctx, "Update DB error", "component", "ClientService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Updated client", "component", "ClientService", "id", c.ID)
+	return nil
+}
+
+func (s *clientService) Delete(ctx context.Context, id int) error {
+	err := s.repo.Delete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Delete DB error", "component", "ClientService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Deleted client", "component", "ClientService", "id", id)
+	return nil
+}
+
+// Reorder sets the order of the brand strip to match ids.
+func (s *clientService) Reorder(ctx context.Context, ids []int) error {
+	if err := validateOrder(ids); err != nil {
+		return err
+	}
+	err := s.repo.Reorder(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Reorder DB error", "component", "ClientService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Reordered clients", "component", "ClientService", "ids", ids)
+	return nil
+}
+
+// validate checks the client fields and that its logo refers to uploaded media.
+func (s *clientService) validate(ctx context.Context, c *model.Client) error {
+	if err := validation.ValidateClient(c); err != nil {
+		return err
+	}
+	logo, err := s.media.GetByID(ctx, c.LogoMediaID)
+	if err != nil {
+		return errors.New("client logo must refer to uploaded media")
+	}
+	c.LogoURL = logo.URL
+	return nil
+}
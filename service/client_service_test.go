@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"porto/model"
+	"testing"
+)
+
+type mockClientRepo struct {
+	CreateFunc func(ctx context.Context, c *model.Client) error
+}
+
+func (m *mockClientRepo) GetAll(ctx context.Context) ([]model.Client, error) { return nil, nil }
+func (m *mockClientRepo) GetByID(ctx context.Context, id int) (*model.Client, error) {
+	return nil, nil
+}
+func (m *mockClientRepo) Create(ctx context.Context, c *model.Client) error {
+	return m.CreateFunc(ctx, c)
+}
+func (m *mockClientRepo) Update(ctx context.Context, c *model.Client) error { return nil }
+func (m *mockClientRepo) Delete(ctx context.Context, id int) error          { return nil }
+func (m *mockClientRepo) Reorder(ctx context.Context, ids []int) error      { return nil }
+
+func TestClientService_Create(t *testing.T) {
+	repo := &mockClientRepo{CreateFunc: func(ctx context.Context, c *model.Client) error { return nil }}
+	media := &mockMediaRepo{byID: map[int]*model.Media{3: {ID: 3, URL: "/uploads/acme.png"}}}
+	svc := NewClientService(repo, media)
+
+	// valid
+	c := &model.Client{Name: "Acme", LogoMediaID: 3}
+	if err := svc.Create(context.Background(), c); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if c.LogoURL != "/uploads/acme.png" {
+		t.Errorf("expected logo url to be resolved, got %q", c.LogoURL)
+	}
+
+	// unknown media
+	if err := svc.Create(context.Background(), &model.Client{Name: "Acme", LogoMediaID: 4}); err == nil {
+		t.Error("expected error for unknown logo media")
+	}
+
+	// invalid
+	if err := svc.Create(context.Background(), &model.Client{Name: "", LogoMediaID: 3}); err == nil {
+		t.Error("expected error for empty name")
+	}
+}
+
+func TestClientService_Reorder_Duplicates(t *testing.T) {
+	svc := NewClientService(&mockClientRepo{}, &mockMediaRepo{})
+	if err := svc.Reorder(context.Background(), []int{1, 2, 1}); err == nil {
+		t.Error("expected error for duplicate ids")
+	}
+}
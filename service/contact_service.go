@@ -2,29 +2,70 @@ package service
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"porto/mailer"
+	"porto/metrics"
 	"porto/model"
 	"porto/repository"
+	"porto/spam"
 	"porto/validation"
+	"strings"
 )
 
 type ContactService interface {
-	GetAll(ctx context.Context) ([]model.Contact, error)
+	// GetAll lists contacts with the given status; an empty status lists the
+	// inbox, which leaves out spam.
+	GetAll(ctx context.Context, status string) ([]model.Contact, error)
 	GetByID(ctx context.Context, id int) (*model.Contact, error)
 	Create(ctx context.Context, c *model.Contact) error
+	// Submit stores a message from the public contact form after the spam
+	// checks. Flagged messages get the spam status and send no e-mail. It
+	// returns spam.ErrRateLimited when the sender submits too often.
+	Submit(ctx context.Context, c *model.Contact, sub spam.Submission) error
+	// FormToken returns the signed timestamp to embed in the contact form.
+	FormToken() string
+	UpdateStatus(ctx context.Context, id int, status string) error
+	// BulkUpdateStatus returns how many contacts were changed.
+	BulkUpdateStatus(ctx context.Context, ids []int, status string) (int, error)
+	UpdateNotes(ctx context.Context, id int, notes string) error
+	Counts(ctx context.Context) (*model.ContactCounts, error)
 	Delete(ctx context.Context, id int) error
 }
 
+// ContactNotifications configures the e-mails sent when a message arrives.
+// Notifications are disabled when Mailer is nil.
+type ContactNotifications struct {
+	Mailer mailer.Mailer
+	From   string
+	// Owner receives a copy of every new message.
+	Owner string
+	// AutoReply also thanks the sender for their message. The address is
+	// not verified, so the reply is a fixed text that quotes nothing the
+	// visitor wrote.
+	AutoReply bool
+}
+
 type contactService struct {
-	repo repository.ContactRepository
+	repo   repository.ContactRepository
+	notify ContactNotifications
+	guard  *spam.Guard
 }
 
-func NewContactService(repo repository.ContactRepository) ContactService {
-	return &contactService{repo}
+// NewContactService screens submissions with guard; a nil guard accepts
+// every valid message.
+func NewContactService(repo repository.ContactRepository, notify ContactNotifications, guard *spam.Guard) ContactService {
+	return &contactService{repo: repo, notify: notify, guard: guard}
 }
 
-func (s *contactService) GetAll(ctx context.Context) ([]model.Contact, error) {
-	return s.repo.GetAll(ctx)
+func (s *contactService) GetAll(ctx context.Context, status string) ([]model.Contact, error) {
+	if status != "" {
+		if err := validation.ValidateContactStatus(status); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.GetAll(ctx, status)
 }
 
 func (s *contactService) GetByID(ctx context.Context, id int) (*model.Contact, error) {
@@ -33,24 +74,156 @@ func (s *contactService) GetByID(ctx context.Context, id int) (*model.Contact, e
 
 func (s *contactService) Create(ctx context.Context, c *model.Contact) error {
 	if err := validation.ValidateContact(c); err != nil {
-		log.Printf("[ContactService] Create validation error: %v", err)
+		slog.WarnContext(ctx, "Create validation error", "component", "ContactService", "error", err)
 		return err
 	}
+	c.Status = model.ContactNew
+	return s.store(ctx, c)
+}
+
+func (s *contactService) Submit(ctx context.Context, c *model.Contact, sub spam.Submission) error {
+	if err := validation.ValidateContact(c); err != nil {
+		slog.WarnContext(ctx, "Submit validation error", "component", "ContactService", "error", err)
+		return err
+	}
+	c.Status = model.ContactNew
+	if s.guard != nil {
+		v, err := s.guard.Check(sub, c.Email, c.Name, c.Subject, c.Message)
+		if err != nil {
+			slog.WarnContext(ctx, "Submit rejected", "component", "ContactService", "ip", sub.IP, "error", err)
+			return err
+		}
+		if v.Spam {
+			c.Status = model.ContactSpam
+			c.SpamScore = v.Score
+			c.SpamReasons = strings.Join(v.Reasons, ", ")
+		}
+	}
+	return s.store(ctx, c)
+}
+
+func (s *contactService) FormToken() string {
+	if s.guard == nil {
+		return ""
+	}
+	return s.guard.Token()
+}
+
+func (s *contactService) store(ctx context.Context, c *model.Contact) error {
 	err := s.repo.Create(ctx, c)
 	if err != nil {
-		log.Printf("[ContactService] Create DB error: %v", err)
+		slog.ErrorContext(ctx, "Create DB error", "component", "ContactService", "error", err)
 		return err
 	}
-	log.Printf("[ContactService] Created contact: %+v", c)
+	metrics.ContactsReceived.WithLabelValues(c.Status).Inc()
+	slog.InfoContext(ctx, "Created contact", "component", "ContactService", "id", c.ID, "status", c.Status)
+	if c.Status != model.ContactSpam {
+		s.sendNotifications(ctx, c)
+	}
 	return nil
 }
 
+// sendNotifications e-mails the owner and, optionally, the sender. The
+// message is already stored, so mail errors are logged rather than returned.
+func (s *contactService) sendNotifications(ctx context.Context, c *model.Contact) {
+	if s.notify.Mailer == nil {
+		return
+	}
+	if s.notify.Owner != "" {
+		s.send(ctx, "contact_notification", c, mailer.Message{
+			From:    s.notify.From,
+			To:      []string{s.notify.Owner},
+			ReplyTo: c.Email,
+			Subject: notificationSubject(c),
+		})
+	}
+	if s.notify.AutoReply {
+		s.send(ctx, "contact_autoreply", c, mailer.Message{
+			From:    s.notify.From,
+			To:      []string{c.Email},
+			Subject: "Thanks for your message",
+		})
+	}
+}
+
+func (s *contactService) send(ctx context.Context, tmpl string, c *model.Contact, msg mailer.Message) {
+	var err error
+	msg.Text, msg.HTML, err = mailer.Render(tmpl, c)
+	if err != nil {
+		slog.ErrorContext(ctx, "Render error", "component", "ContactService", "template", tmpl, "error", err)
+		return
+	}
+	if err := s.notify.Mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "Send error", "component", "ContactService", "template", tmpl, "error", err)
+	}
+}
+
+// UpdateStatus returns sql.ErrNoRows when no contact has the given id.
+func (s *contactService) UpdateStatus(ctx context.Context, id int, status string) error {
+	n, err := s.BulkUpdateStatus(ctx, []int{id}, status)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (s *contactService) BulkUpdateStatus(ctx context.Context, ids []int, status string) (int, error) {
+	if len(ids) == 0 {
+		return 0, errors.New("ids are required")
+	}
+	if err := validation.ValidateContactStatus(status); err != nil {
+		slog.WarnContext(ctx, "UpdateStatus validation error", "component", "ContactService", "error", err)
+		return 0, err
+	}
+	n, err := s.repo.UpdateStatus(ctx, ids, status)
+	if err != nil {
+		slog.ErrorContext(ctx, "UpdateStatus DB error", "component", "ContactService", "error", err)
+		return 0, err
+	}
+	slog.InfoContext(ctx, "Updated contact status", "component", "ContactService", "count", n, "status", status)
+	return n, nil
+}
+
+func (s *contactService) UpdateNotes(ctx context.Context, id int, notes string) error {
+	if err := s.repo.UpdateNotes(ctx, id, notes); err != nil {
+		slog.ErrorContext(ctx, "UpdateNotes DB error", "component", "ContactService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Updated notes for contact", "component", "ContactService", "id", id)
+	return nil
+}
+
+// Counts reports every status, including those without contacts.
+func (s *contactService) Counts(ctx context.Context) (*model.ContactCounts, error) {
+	byStatus, err := s.repo.CountByStatus(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Counts DB error", "component", "ContactService", "error", err)
+		return nil, err
+	}
+	counts := &model.ContactCounts{ByStatus: make(map[string]int, len(model.ContactStatuses))}
+	for _, status := range model.ContactStatuses {
+		counts.ByStatus[status] = byStatus[status]
+	}
+	counts.Unread = counts.ByStatus[model.ContactNew]
+	return counts, nil
+}
+
 func (s *contactService) Delete(ctx context.Context, id int) error {
 	err := s.repo.Delete(ctx, id)
 	if err != nil {
-		log.Printf("[ContactService] Delete DB error: %v", err)
+		slog.ErrorContext(ctx, "Delete DB error", "component", "ContactService", "error", err)
 		return err
 	}
-	log.Printf("[ContactService] Deleted contact id: %d", id)
+	slog.InfoContext(ctx, "Deleted contact", "component", "ContactService", "id", id)
 	return nil
 }
+
+func notificationSubject(c *model.Contact) string {
+	if c.Subject != "" {
+		return "New message from " + c.Name + ": " + c.Subject
+	}
+	return "New message from " + c.Name
+}
@@ -2,22 +2,37 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"porto/mailer"
 	"porto/model"
+	"porto/spam"
+	"strings"
 	"testing"
 )
 
 type mockContactRepo struct {
-	CreateFunc func(ctx context.Context, c *model.Contact) error
+	CreateFunc        func(ctx context.Context, c *model.Contact) error
+	UpdateStatusFunc  func(ctx context.Context, ids []int, status string) (int, error)
+	CountByStatusFunc func(ctx context.Context) (map[string]int, error)
 }
 
-func (m *mockContactRepo) GetAll(ctx context.Context) ([]model.Contact, error) { return nil, nil }
+func (m *mockContactRepo) GetAll(ctx context.Context, status string) ([]model.Contact, error) {
+	return nil, nil
+}
 func (m *mockContactRepo) GetByID(ctx context.Context, id int) (*model.Contact, error) {
 	return nil, nil
 }
 func (m *mockContactRepo) Create(ctx context.Context, c *model.Contact) error {
 	return m.CreateFunc(ctx, c)
 }
+func (m *mockContactRepo) UpdateStatus(ctx context.Context, ids []int, status string) (int, error) {
+	return m.UpdateStatusFunc(ctx, ids, status)
+}
+func (m *mockContactRepo) UpdateNotes(ctx context.Context, id int, notes string) error { return nil }
+func (m *mockContactRepo) CountByStatus(ctx context.Context) (map[string]int, error) {
+	return m.CountByStatusFunc(ctx)
+}
 func (m *mockContactRepo) Delete(ctx context.Context, id int) error { return nil }
 
 func TestContactService_Create(t *testing.T) {
@@ -29,7 +44,7 @@ func TestContactService_Create(t *testing.T) {
 			return nil
 		},
 	}
-	svc := NewContactService(repo)
+	svc := NewContactService(repo, ContactNotifications{}, nil)
 
 	// valid
 	c := &model.Contact{Name: "A", Email: "a@mail.com", Message: "hi"}
@@ -55,10 +70,121 @@ func TestContactService_Create(t *testing.T) {
 
 func TestContactService_Create_Invalid(t *testing.T) {
 	repo := &mockContactRepo{CreateFunc: func(ctx context.Context, c *model.Contact) error { return nil }}
-	svc := NewContactService(repo)
+	svc := NewContactService(repo, ContactNotifications{}, nil)
 	c := &model.Contact{Name: "", Email: "", Message: ""}
 	err := svc.Create(context.Background(), c)
 	if err == nil {
 		t.Error("expected error for invalid input")
 	}
 }
+
+func TestContactService_Create_Notifications(t *testing.T) {
+	repo := &mockContactRepo{CreateFunc: func(ctx context.Context, c *model.Contact) error { return nil }}
+	m := mailer.NewMemoryMailer()
+	svc := NewContactService(repo, ContactNotifications{Mailer: m, From: "site@mail.com", Owner: "owner@mail.com", AutoReply: true}, nil)
+
+	c := &model.Contact{Name: "A", Email: "a@mail.com", Message: "<b>hi</b>"}
+	if err := svc.Create(context.Background(), c); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	sent := m.Sent()
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(sent))
+	}
+	if sent[0].To[0] != "owner@mail.com" || sent[0].ReplyTo != "a@mail.com" {
+		t.Errorf("expected owner notification replying to sender, got %+v", sent[0])
+	}
+	if !strings.Contains(sent[0].Text, "<b>hi</b>") || !strings.Contains(sent[0].HTML, "&lt;b&gt;hi&lt;/b&gt;") {
+		t.Errorf("expected message in text and escaped in HTML, got %q / %q", sent[0].Text, sent[0].HTML)
+	}
+	if sent[1].To[0] != "a@mail.com" {
+		t.Errorf("expected auto-reply to sender, got %+v", sent[1])
+	}
+	if strings.Contains(sent[1].Text, "<b>hi</b>") || strings.Contains(sent[1].HTML, "&lt;b&gt;hi") {
+		t.Errorf("expected auto-reply not to quote the message, got %q", sent[1].Text)
+	}
+
+	// invalid contacts send nothing
+	svc.Create(context.Background(), &model.Contact{})
+	if len(m.Sent()) != 2 {
+		t.Errorf("expected no mail for invalid contact, got %d", len(m.Sent()))
+	}
+}
+
+func TestContactService_UpdateStatus(t *testing.T) {
+	repo := &mockContactRepo{UpdateStatusFunc: func(ctx context.Context, ids []int, status string) (int, error) {
+		if ids[0] == 404 {
+			return 0, nil
+		}
+		return len(ids), nil
+	}}
+	svc := NewContactService(repo, ContactNotifications{}, nil)
+
+	if err := svc.UpdateStatus(context.Background(), 1, model.ContactRead); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := svc.UpdateStatus(context.Background(), 404, model.ContactRead); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	if err := svc.UpdateStatus(context.Background(), 1, "deleted"); err == nil {
+		t.Error("expected error for unknown status")
+	}
+	if n, err := svc.BulkUpdateStatus(context.Background(), []int{1, 2, 3}, model.ContactArchived); err != nil || n != 3 {
+		t.Errorf("expected 3 updated, got %d, err %v", n, err)
+	}
+	if _, err := svc.BulkUpdateStatus(context.Background(), nil, model.ContactArchived); err == nil {
+		t.Error("expected error for empty ids")
+	}
+}
+
+func TestContactService_Counts(t *testing.T) {
+	repo := &mockContactRepo{CountByStatusFunc: func(ctx context.Context) (map[string]int, error) {
+		return map[string]int{model.ContactNew: 4, model.ContactSpam: 1}, nil
+	}}
+	svc := NewContactService(repo, ContactNotifications{}, nil)
+
+	counts, err := svc.Counts(context.Background())
+	if err != nil || counts.Unread != 4 {
+		t.Fatalf("expected 4 unread, got %+v, err %v", counts, err)
+	}
+	if len(counts.ByStatus) != len(model.ContactStatuses) || counts.ByStatus[model.ContactRead] != 0 {
+		t.Errorf("expected every status to be reported, got %v", counts.ByStatus)
+	}
+}
+
+func TestContactService_Submit(t *testing.T) {
+	var stored []model.Contact
+	repo := &mockContactRepo{CreateFunc: func(ctx context.Context, c *model.Contact) error {
+		stored = append(stored, *c)
+		return nil
+	}}
+	m := mailer.NewMemoryMailer()
+	guard := spam.NewGuard(spam.DefaultConfig([]byte("secret")))
+	svc := NewContactService(repo, ContactNotifications{Mailer: m, Owner: "owner@mail.com"}, guard)
+
+	// honeypot filled: stored as spam without notifying the owner
+	c := &model.Contact{Name: "A", Email: "a@mail.com", Message: "hi"}
+	if err := svc.Submit(context.Background(), c, spam.Submission{IP: "1.2.3.4", Honeypot: "x", Token: svc.FormToken()}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stored[0].Status != model.ContactSpam || stored[0].SpamReasons == "" {
+		t.Errorf("expected spam status with reasons, got %+v", stored[0])
+	}
+	if len(m.Sent()) != 0 {
+		t.Errorf("expected no mail for spam, got %d", len(m.Sent()))
+	}
+
+	// invalid input is rejected before the spam checks
+	if err := svc.Submit(context.Background(), &model.Contact{}, spam.Submission{}); err == nil {
+		t.Error("expected validation error")
+	}
+
+	// rate limited
+	for i := 0; i < 5; i++ {
+		svc.Submit(context.Background(), &model.Contact{Name: "A", Email: "b@mail.com", Message: "hi"}, spam.Submission{IP: "5.6.7.8"})
+	}
+	err := svc.Submit(context.Background(), &model.Contact{Name: "A", Email: "b@mail.com", Message: "hi"}, spam.Submission{IP: "5.6.7.8"})
+	if !errors.Is(err, spam.ErrRateLimited) {
+		t.Errorf("expected rate limit error, got %v", err)
+	}
+}
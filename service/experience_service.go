@@ -3,10 +3,14 @@ package service
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
+	"log/slog"
 	"porto/model"
 	"porto/repository"
+	"porto/tracing"
 	"porto/validation"
+	"sort"
+	"time"
 )
 
 type ExperienceService interface {
@@ -19,58 +23,131 @@ type ExperienceService interface {
 
 type experienceService struct {
 	repo repository.ExperienceRepository
+	now  func() time.Time
 }
 
 func NewExperienceService(repo repository.ExperienceRepository) ExperienceService {
-	return &experienceService{repo}
+	return &experienceService{repo: repo, now: time.Now}
 }
 
+// GetAll returns the experience timeline, newest first: current positions
+// lead, the rest are ordered by start date descending.
 func (s *experienceService) GetAll(ctx context.Context) ([]model.Experience, error) {
-	return s.repo.GetAll(ctx)
+	ctx, span := tracing.Start(ctx, "ExperienceService.GetAll")
+	defer span.End()
+	exps, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(exps, func(i, j int) bool {
+		if exps[i].IsCurrent != exps[j].IsCurrent {
+			return exps[i].IsCurrent
+		}
+		return exps[i].StartDate.After(exps[j].StartDate.Time)
+	})
+	for i := range exps {
+		s.fillTenure(&exps[i])
+	}
+	return exps, nil
 }
 
 func (s *experienceService) GetByID(ctx context.Context, id int) (*model.Experience, error) {
-	return s.repo.GetByID(ctx, id)
+	ctx, span := tracing.Start(ctx, "ExperienceService.GetByID")
+	defer span.End()
+	e, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.fillTenure(e)
+	return e, nil
+}
+
+func (s *experienceService) fillTenure(e *model.Experience) {
+	if e == nil || e.StartDate.IsZero() {
+		return
+	}
+	end := e.EndDate.Time
+	if e.IsCurrent || e.EndDate.IsZero() {
+		end = s.now()
+	}
+	e.Tenure = formatTenure(e.StartDate.Time, end)
+}
+
+// formatTenure renders the whole months between start and end as e.g.
+// "2 yrs 3 mos".
+func formatTenure(start, end time.Time) string {
+	months := (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+	if end.Day() < start.Day() {
+		months--
+	}
+	if months < 1 {
+		return "less than a month"
+	}
+	years, months := months/12, months%12
+	var out string
+	switch {
+	case years == 1:
+		out = "1 yr"
+	case years > 1:
+		out = fmt.Sprintf("%d yrs", years)
+	}
+	if months > 0 {
+		if out != "" {
+			out += " "
+		}
+		if months == 1 {
+			out += "1 mo"
+		} else {
+			out += fmt.Sprintf("%d mos", months)
+		}
+	}
+	return out
 }
 
 func (s *experienceService) Create(ctx context.Context, e *model.Experience) error {
+	ctx, span := tracing.Start(ctx, "ExperienceService.Create")
+	defer span.End()
 	if err := validation.ValidateExperience(e); err != nil {
-		log.Printf("[ExperienceService] Create validation error: %v", err)
+		slog.WarnContext(ctx, "Create validation error", "component", "ExperienceService", "error", err)
 		return err
 	}
 	err := s.repo.Create(ctx, e)
 	if err != nil {
-		log.Printf("[ExperienceService] Create DB error: %v", err)
+		slog.ErrorContext(ctx, "Create DB error", "component", "ExperienceService", "error", err)
 		return err
 	}
-	log.Printf("[ExperienceService] Created experience: %+v", e)
+	slog.InfoContext(ctx, "Created experience", "component", "ExperienceService", "id", e.ID)
 	return nil
 }
 
 func (s *experienceService) Update(ctx context.Context, e *model.Experience) error {
+	ctx, span := tracing.Start(ctx, "ExperienceService.Update")
+	defer span.End()
 	if e.ID == 0 {
-		log.Printf("[ExperienceService] Update error: id is required")
+		slog.WarnContext(ctx, "Update error: id is required", "component", "ExperienceService")
 		return errors.New("id is required")
 	}
 	if err := validation.ValidateExperience(e); err != nil {
-		log.Printf("[ExperienceService] Update validation error: %v", err)
+		slog.WarnContext(ctx, "Update validation error", "component", "ExperienceService", "error", err)
 		return err
 	}
 	err := s.repo.Update(ctx, e)
 	if err != nil {
-		log.Printf("[ExperienceService] Update DB error: %v", err)
+		slog.ErrorContext(ctx, "Update DB error", "component", "ExperienceService", "error", err)
 		return err
 	}
-	log.Printf("[ExperienceService] Updated experience: %+v", e)
+	slog.InfoContext(ctx, "Updated experience", "component", "ExperienceService", "id", e.ID)
 	return nil
 }
 
 func (s *experienceService) Delete(ctx context.Context, id int) error {
+	ctx, span := tracing.Start(ctx, "ExperienceService.Delete")
+	defer span.End()
 	err := s.repo.Delete(ctx, id)
 	if err != nil {
-		log.Printf("[ExperienceService] Delete DB error: %v", err)
+		slog.ErrorContext(ctx, "Delete DB error", "component", "ExperienceService", "error", err)
 		return err
 	}
-	log.Printf("[ExperienceService] Deleted experience id: %d", id)
+	slog.InfoContext(ctx, "Deleted experience", "component", "ExperienceService", "id", id)
 	return nil
 }
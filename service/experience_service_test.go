@@ -5,15 +5,19 @@ import (
 	"errors"
 	"porto/model"
 	"testing"
+	"time"
 )
 
 type mockExperienceRepo struct {
+	GetAllFunc func(ctx context.Context) ([]model.Experience, error)
 	CreateFunc func(ctx context.Context, e *model.Experience) error
 	UpdateFunc func(ctx context.Context, e *model.Experience) error
 	DeleteFunc func(ctx context.Context, id int) error
 }
 
-func (m *mockExperienceRepo) GetAll(ctx context.Context) ([]model.Experience, error) { return nil, nil }
+func (m *mockExperienceRepo) GetAll(ctx context.Context) ([]model.Experience, error) {
+	return m.GetAllFunc(ctx)
+}
 func (m *mockExperienceRepo) GetByID(ctx context.Context, id int) (*model.Experience, error) {
 	return nil, nil
 }
@@ -39,21 +43,21 @@ func TestExperienceService_Create(t *testing.T) {
 	svc := NewExperienceService(repo)
 
 	// valid
-	e := &model.Experience{Title: "A", Company: "B", StartDate: "2020"}
+	e := &model.Experience{Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)}
 	err := svc.Create(context.Background(), e)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
 
 	// invalid
-	e2 := &model.Experience{Title: "", Company: "B", StartDate: "2020"}
+	e2 := &model.Experience{Title: "", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)}
 	err = svc.Create(context.Background(), e2)
 	if err == nil {
 		t.Errorf("expected error for empty title")
 	}
 
 	// duplicate
-	e3 := &model.Experience{Title: "exists", Company: "B", StartDate: "2020"}
+	e3 := &model.Experience{Title: "exists", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)}
 	err = svc.Create(context.Background(), e3)
 	if err == nil || err.Error() != "duplicate" {
 		t.Errorf("expected duplicate error, got %v", err)
@@ -63,7 +67,7 @@ func TestExperienceService_Create(t *testing.T) {
 func TestExperienceService_Create_Invalid(t *testing.T) {
 	repo := &mockExperienceRepo{CreateFunc: func(ctx context.Context, e *model.Experience) error { return nil }}
 	svc := NewExperienceService(repo)
-	e := &model.Experience{Title: "", Company: "", StartDate: ""}
+	e := &model.Experience{Title: "", Company: ""}
 	err := svc.Create(context.Background(), e)
 	if err == nil {
 		t.Error("expected error for invalid input")
@@ -73,7 +77,7 @@ func TestExperienceService_Create_Invalid(t *testing.T) {
 func TestExperienceService_Update_Invalid(t *testing.T) {
 	repo := &mockExperienceRepo{}
 	svc := NewExperienceService(repo)
-	e := &model.Experience{ID: 0, Title: "A", Company: "B", StartDate: "2020"}
+	e := &model.Experience{ID: 0, Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)}
 	err := svc.Update(context.Background(), e)
 	if err == nil {
 		t.Error("expected error for missing id")
@@ -83,7 +87,7 @@ func TestExperienceService_Update_Invalid(t *testing.T) {
 func TestExperienceService_Update_Success(t *testing.T) {
 	repo := &mockExperienceRepo{UpdateFunc: func(ctx context.Context, e *model.Experience) error { return nil }}
 	svc := NewExperienceService(repo)
-	e := &model.Experience{ID: 1, Title: "A", Company: "B", StartDate: "2020"}
+	e := &model.Experience{ID: 1, Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)}
 	err := svc.Update(context.Background(), e)
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
@@ -93,7 +97,7 @@ func TestExperienceService_Update_Success(t *testing.T) {
 func TestExperienceService_Update_RepoError(t *testing.T) {
 	repo := &mockExperienceRepo{UpdateFunc: func(ctx context.Context, e *model.Experience) error { return errors.New("db error") }}
 	svc := NewExperienceService(repo)
-	e := &model.Experience{ID: 1, Title: "A", Company: "B", StartDate: "2020"}
+	e := &model.Experience{ID: 1, Title: "A", Company: "B", StartDate: model.NewDate(2020, time.January, 1), EndDate: model.NewDate(2021, time.March, 1)}
 	err := svc.Update(context.Background(), e)
 	if err == nil {
 		t.Error("expected error from repo")
@@ -117,3 +121,44 @@ func TestExperienceService_Delete_RepoError(t *testing.T) {
 		t.Error("expected error from repo")
 	}
 }
+
+func TestExperienceService_GetAll_Timeline(t *testing.T) {
+	repo := &mockExperienceRepo{GetAllFunc: func(ctx context.Context) ([]model.Experience, error) {
+		return []model.Experience{
+			{ID: 1, StartDate: model.NewDate(2015, time.February, 1), EndDate: model.NewDate(2018, time.February, 1)},
+			{ID: 2, StartDate: model.NewDate(2021, time.January, 15), IsCurrent: true},
+			{ID: 3, StartDate: model.NewDate(2018, time.March, 1), EndDate: model.NewDate(2020, time.June, 1)},
+		}, nil
+	}}
+	svc := &experienceService{repo: repo, now: func() time.Time {
+		return time.Date(2023, time.April, 20, 0, 0, 0, 0, time.UTC)
+	}}
+	exps, err := svc.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	wantIDs := []int{2, 3, 1}
+	wantTenure := []string{"2 yrs 3 mos", "2 yrs 3 mos", "3 yrs"}
+	for i, e := range exps {
+		if e.ID != wantIDs[i] || e.Tenure != wantTenure[i] {
+			t.Errorf("position %d: got id %d tenure %q, want id %d tenure %q", i, e.ID, e.Tenure, wantIDs[i], wantTenure[i])
+		}
+	}
+}
+
+func TestFormatTenure(t *testing.T) {
+	cases := []struct {
+		start, end time.Time
+		want       string
+	}{
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 20, 0, 0, 0, 0, time.UTC), "less than a month"},
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), "1 mo"},
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "1 yr"},
+		{time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2022, 4, 14, 0, 0, 0, 0, time.UTC), "2 yrs 2 mos"},
+	}
+	for _, c := range cases {
+		if got := formatTenure(c.start, c.end); got != c.want {
+			t.Errorf("formatTenure(%v, %v) = %q, want %q", c.start, c.end, got, c.want)
+		}
+	}
+}
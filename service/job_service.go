@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"porto/model"
+	"porto/repository"
+)
+
+// DefaultMaxAttempts is how often a job runs before it is marked dead.
+const DefaultMaxAttempts = 5
+
+type JobService interface {
+	// Enqueue stores a job of the given kind with payload encoded as JSON.
+	Enqueue(ctx context.Context, kind string, payload any) error
+	GetAll(ctx context.Context, status string) ([]model.Job, error)
+	Retry(ctx context.Context, id int) error
+}
+
+type jobService struct {
+	repo repository.JobRepository
+}
+
+func NewJobService(repo repository.JobRepository) JobService {
+	return &jobService{repo}
+}
+
+func (s *jobService) Enqueue(ctx context.Context, kind string, payload any) error {
+	if kind == "" {
+		return errors.New("job kind is required")
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	j := &model.Job{Kind: kind, Payload: data, MaxAttempts: DefaultMaxAttempts}
+	if err := s.repo.Enqueue(ctx, j); err != nil {
+		slog.ErrorContext(ctx, "Enqueue DB error", "component", "JobService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Enqueued job", "component", "JobService", "kind", kind, "id", j.ID)
+	return nil
+}
+
+// GetAll lists recent jobs; an empty status lists every status.
+func (s *jobService) GetAll(ctx context.Context, status string) ([]model.Job, error) {
+	switch status {
+	case "", model.JobQueued, model.JobRunning, model.JobDone, model.JobDead:
+		return s.repo.GetAll(ctx, status)
+	default:
+		return nil, errors.New("unknown job status: " + status)
+	}
+}
+
+func (s *jobService) Retry(ctx context.Context, id int) error {
+	if err := s.repo.Retry(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Retry error", "component", "JobService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Re-queued job", "component", "JobService", "id", id)
+	return nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"porto/model"
+	"testing"
+	"time"
+)
+
+type mockJobRepo struct {
+	EnqueueFunc func(ctx context.Context, j *model.Job) error
+}
+
+func (m *mockJobRepo) GetAll(ctx context.Context, status string) ([]model.Job, error) {
+	return nil, nil
+}
+func (m *mockJobRepo) Enqueue(ctx context.Context, j *model.Job) error { return m.EnqueueFunc(ctx, j) }
+func (m *mockJobRepo) Claim(ctx context.Context, lease time.Duration) (*model.Job, error) {
+	return nil, nil
+}
+func (m *mockJobRepo) MarkDone(ctx context.Context, id int) error { return nil }
+func (m *mockJobRepo) Reschedule(ctx context.Context, id int, lastErr string, runAt time.Time) error {
+	return nil
+}
+func (m *mockJobRepo) MarkDead(ctx context.Context, id int, lastErr string) error { return nil }
+func (m *mockJobRepo) Retry(ctx context.Context, id int) error                    { return nil }
+
+func TestJobService_Enqueue(t *testing.T) {
+	var got *model.Job
+	svc := NewJobService(&mockJobRepo{EnqueueFunc: func(ctx context.Context, j *model.Job) error {
+		got = j
+		return nil
+	}})
+
+	if err := svc.Enqueue(context.Background(), "send_mail", map[string]string{"to": "a@mail.com"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Kind != "send_mail" || string(got.Payload) != `{"to":"a@mail.com"}` || got.MaxAttempts != DefaultMaxAttempts {
+		t.Errorf("unexpected job %+v", got)
+	}
+
+	if err := svc.Enqueue(context.Background(), "", nil); err == nil {
+		t.Error("expected error for empty kind")
+	}
+}
+
+func TestJobService_GetAll_InvalidStatus(t *testing.T) {
+	svc := NewJobService(&mockJobRepo{})
+	if _, err := svc.GetAll(context.Background(), "bogus"); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"porto/model"
+	"porto/repository"
+)
+
+// MaxMediaSize is the largest upload accepted by the media service.
+const MaxMediaSize = 5 << 20
+
+var allowedMediaTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+type MediaService interface {
+	GetAll(ctx context.Context) ([]model.Media, error)
+	GetByID(ctx context.Context, id int) (*model.Media, error)
+	// Upload stores an image on disk and records it. The content type is
+	// sniffed from the data rather than trusted from the client.
+	Upload(ctx context.Context, fileName string, r io.Reader) (*model.Media, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type mediaService struct {
+	repo    repository.MediaRepository
+	dir     string
+	baseURL string
+}
+
+// NewMediaService stores files in dir and serves them under baseURL, e.g.
+// "uploads" and "/uploads".
+func NewMediaService(repo repository.MediaRepository, dir, baseURL string) MediaService {
+	return &mediaService{repo: repo, dir: dir, baseURL: baseURL}
+}
+
+func (s *mediaService) GetAll(ctx context.Context) ([]model.Media, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *mediaService) GetByID(ctx context.Context, id int) (*model.Media, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *mediaService) Upload(ctx context.Context, fileName string, r io.Reader) (*model.Media, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxMediaSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if len(data) > MaxMediaSize {
+		return nil, errors.New("file is larger than 5 MB")
+	}
+	contentType := http.DetectContentType(data)
+	ext, ok := allowedMediaTypes[contentType]
+	if !ok {
+		return nil, errors.New("unsupported file type " + contentType)
+	}
+
+	name, err := randomName()
+	if err != nil {
+		return nil, err
+	}
+	name += ext
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, err
+	}
+	dst := filepath.Join(s.dir, name)
+	if err := os.WriteFile(dst, data, 0o644); err != nil {
+		slog.ErrorContext(ctx, "Upload write error", "component", "MediaService", "error", err)
+		return nil, err
+	}
+
+	m := &model.Media{
+		FileName:    filepath.Base(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		URL:         path.Join(s.baseURL, name),
+	}
+	if err := s.repo.Create(ctx, m); err != nil {
+		slog.ErrorContext(ctx, "Upload DB error", "component", "MediaService", "error", err)
+		os.Remove(dst)
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Uploaded media", "component", "MediaService", "id", m.ID, "content_type", contentType, "size", m.Size)
+	return m, nil
+}
+
+func (s *mediaService) Delete(ctx context.Context, id int) error {
+	m, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		slog.ErrorContext(ctx, "Delete DB error", "component", "MediaService", "error", err)
+		return err
+	}
+	if err := os.Remove(filepath.Join(s.dir, path.Base(m.URL))); err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.ErrorContext(ctx, "Delete file error", "component", "MediaService", "error", err)
+	}
+	slog.InfoContext(ctx, "Deleted media", "component", "MediaService", "id", id)
+	return nil
+}
+
+func randomName() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
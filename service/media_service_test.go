@@ -0,0 +1,65 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"porto/model"
+	"strings"
+	"testing"
+)
+
+type mockMediaRepo struct {
+	created []model.Media
+	byID    map[int]*model.Media
+}
+
+func (m *mockMediaRepo) GetAll(ctx context.Context) ([]model.Media, error) { return m.created, nil }
+func (m *mockMediaRepo) GetByID(ctx context.Context, id int) (*model.Media, error) {
+	if media, ok := m.byID[id]; ok {
+		return media, nil
+	}
+	return nil, os.ErrNotExist
+}
+func (m *mockMediaRepo) Create(ctx context.Context, media *model.Media) error {
+	media.ID = len(m.created) + 1
+	m.created = append(m.created, *media)
+	return nil
+}
+func (m *mockMediaRepo) Delete(ctx context.Context, id int) error { return nil }
+
+// pngHeader is enough of a PNG file for content sniffing.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestMediaService_Upload(t *testing.T) {
+	dir := t.TempDir()
+	repo := &mockMediaRepo{}
+	svc := NewMediaService(repo, dir, "/uploads")
+
+	m, err := svc.Upload(context.Background(), "../../logo.png", bytes.NewReader(pngHeader))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if m.ContentType != "image/png" || m.FileName != "logo.png" || !strings.HasPrefix(m.URL, "/uploads/") {
+		t.Errorf("unexpected media %+v", m)
+	}
+	if _, err := os.Stat(filepath.Join(dir, filepath.Base(m.URL))); err != nil {
+		t.Errorf("expected file on disk: %v", err)
+	}
+}
+
+func TestMediaService_Upload_Rejects(t *testing.T) {
+	svc := NewMediaService(&mockMediaRepo{}, t.TempDir(), "/uploads")
+
+	if _, err := svc.Upload(context.Background(), "x.html", strings.NewReader("<html><script>alert(1)</script>")); err == nil {
+		t.Error("expected error for non-image upload")
+	}
+	if _, err := svc.Upload(context.Background(), "empty.png", bytes.NewReader(nil)); err == nil {
+		t.Error("expected error for empty upload")
+	}
+	big := append(append([]byte{}, pngHeader...), make([]byte, MaxMediaSize)...)
+	if _, err := svc.Upload(context.Background(), "big.png", bytes.NewReader(big)); err == nil {
+		t.Error("expected error for oversized upload")
+	}
+}
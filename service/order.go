@@ -0,0 +1,18 @@
+package service
+
+import "errors"
+
+// validateOrder checks the ids of a Reorder call: at least one, no repeats.
+func validateOrder(ids []int) error {
+	if len(ids) == 0 {
+		return errors.New("ids are required")
+	}
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return errors.New("ids must not contain duplicates")
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -2,16 +2,25 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
-	"log"
+	"log/slog"
+	"porto/metrics"
 	"porto/model"
 	"porto/repository"
+	"porto/tracing"
 	"porto/validation"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 type PortfolioService interface {
 	GetAll(ctx context.Context) ([]model.Portfolio, error)
 	GetByID(ctx context.Context, id int) (*model.Portfolio, error)
+	GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error)
+	// Create and Update derive a unique slug from the name when it is empty
+	// and normalise tags to lower case without duplicates.
 	Create(ctx context.Context, p *model.Portfolio) error
 	Update(ctx context.Context, p *model.Portfolio) error
 	Delete(ctx context.Context, id int) error
@@ -26,51 +35,136 @@ func NewPortfolioService(repo repository.PortfolioRepository) PortfolioService {
 }
 
 func (s *portfolioService) GetAll(ctx context.Context) ([]model.Portfolio, error) {
+	ctx, span := tracing.Start(ctx, "PortfolioService.GetAll")
+	defer span.End()
 	return s.repo.GetAll(ctx)
 }
 
 func (s *portfolioService) GetByID(ctx context.Context, id int) (*model.Portfolio, error) {
+	ctx, span := tracing.Start(ctx, "PortfolioService.GetByID")
+	defer span.End()
 	return s.repo.GetByID(ctx, id)
 }
 
+func (s *portfolioService) GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error) {
+	ctx, span := tracing.Start(ctx, "PortfolioService.GetBySlug")
+	defer span.End()
+	return s.repo.GetBySlug(ctx, slug)
+}
+
 func (s *portfolioService) Create(ctx context.Context, p *model.Portfolio) error {
+	ctx, span := tracing.Start(ctx, "PortfolioService.Create")
+	defer span.End()
+	normalizePortfolio(p)
+	if err := s.deriveSlug(ctx, p); err != nil {
+		slog.ErrorContext(ctx, "Create slug lookup error", "component", "PortfolioService", "error", err)
+		return err
+	}
 	if err := validation.ValidatePortfolio(p); err != nil {
-		log.Printf("[PortfolioService] Create validation error: %v", err)
+		slog.WarnContext(ctx, "Create validation error", "component", "PortfolioService", "error", err)
 		return err
 	}
 	err := s.repo.Create(ctx, p)
 	if err != nil {
-		log.Printf("[PortfolioService] Create DB error: %v", err)
+		slog.ErrorContext(ctx, "Create DB error", "component", "PortfolioService", "error", err)
 		return err
 	}
-	log.Printf("[PortfolioService] Created portfolio: %+v", p)
+	metrics.ProjectsCreated.Inc()
+	slog.InfoContext(ctx, "Created portfolio", "component", "PortfolioService", "id", p.ID)
 	return nil
 }
 
 func (s *portfolioService) Update(ctx context.Context, p *model.Portfolio) error {
+	ctx, span := tracing.Start(ctx, "PortfolioService.Update")
+	defer span.End()
 	if p.ID == 0 {
-		log.Printf("[PortfolioService] Update error: id is required")
+		slog.WarnContext(ctx, "Update error: id is required", "component", "PortfolioService")
 		return errors.New("id is required")
 	}
+	normalizePortfolio(p)
+	if err := s.deriveSlug(ctx, p); err != nil {
+		slog.ErrorContext(ctx, "Update slug lookup error", "component", "PortfolioService", "error", err)
+		return err
+	}
 	if err := validation.ValidatePortfolio(p); err != nil {
-		log.Printf("[PortfolioService] Update validation error: %v", err)
+		slog.WarnContext(ctx, "Update validation error", "component", "PortfolioService", "error", err)
 		return err
 	}
 	err := s.repo.Update(ctx, p)
 	if err != nil {
-		log.Printf("[PortfolioService] Update DB error: %v", err)
+		slog.ErrorContext(ctx, "Update DB error", "component", "PortfolioService", "error", err)
 		return err
 	}
-	log.Printf("[PortfolioService] Updated portfolio: %+v", p)
+	slog.InfoContext(ctx, "Updated portfolio", "component", "PortfolioService", "id", p.ID)
 	return nil
 }
 
 func (s *portfolioService) Delete(ctx context.Context, id int) error {
+	ctx, span := tracing.Start(ctx, "PortfolioService.Delete")
+	defer span.End()
 	err := s.repo.Delete(ctx, id)
 	if err != nil {
-		log.Printf("[PortfolioService] Delete DB error: %v", err)
+		slog.ErrorContext(ctx, "Delete DB error", "component", "PortfolioService", "error", err)
 		return err
 	}
-	log.Printf("[PortfolioService] Deleted portfolio id: %d", id)
+	slog.InfoContext(ctx, "Deleted portfolio", "component", "PortfolioService", "id", id)
 	return nil
 }
+
+// defaultSlug is the slug of a project whose name has no letters or digits
+// to derive one from.
+const defaultSlug = "project"
+
+// deriveSlug sets the slug from the name when none was given. When another
+// project has it, "-2", "-3" and so on are appended, so clients that cannot
+// send a slug can still reuse a name.
+func (s *portfolioService) deriveSlug(ctx context.Context, p *model.Portfolio) error {
+	if p.Slug != "" {
+		return nil
+	}
+	base := slugify(p.Name)
+	if base == "" {
+		base = defaultSlug
+	}
+	slug := base
+	for n := 2; ; n++ {
+		other, err := s.repo.GetBySlug(ctx, slug)
+		if errors.Is(err, sql.ErrNoRows) || err == nil && other.ID == p.ID {
+			p.Slug = slug
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		slug = base + "-" + strconv.Itoa(n)
+	}
+}
+
+func normalizePortfolio(p *model.Portfolio) {
+	tags := make([]string, 0, len(p.Tags))
+	for _, tag := range p.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	p.Tags = tags
+}
+
+// slugify turns "Company Website (2024)" into "company-website-2024".
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if 'a' <= r && r <= 'z' || '0' <= r && r <= '9' {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return b.String()
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"porto/model"
 	"testing"
@@ -9,12 +10,21 @@ import (
 
 type mockPortfolioRepo struct {
 	CreateFunc func(ctx context.Context, p *model.Portfolio) error
+	// Slugs maps the slugs already taken to their project ids.
+	Slugs map[string]int
 }
 
 func (m *mockPortfolioRepo) GetAll(ctx context.Context) ([]model.Portfolio, error) { return nil, nil }
 func (m *mockPortfolioRepo) GetByID(ctx context.Context, id int) (*model.Portfolio, error) {
 	return nil, nil
 }
+func (m *mockPortfolioRepo) GetBySlug(ctx context.Context, slug string) (*model.Portfolio, error) {
+	id, ok := m.Slugs[slug]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &model.Portfolio{ID: id, Slug: slug}, nil
+}
 func (m *mockPortfolioRepo) Create(ctx context.Context, p *model.Portfolio) error {
 	return m.CreateFunc(ctx, p)
 }
@@ -73,3 +83,46 @@ func TestPortfolioService_Update_Invalid(t *testing.T) {
 		t.Error("expected error for missing id")
 	}
 }
+
+func TestPortfolioService_Create_Normalizes(t *testing.T) {
+	repo := &mockPortfolioRepo{CreateFunc: func(ctx context.Context, p *model.Portfolio) error { return nil }}
+	svc := NewPortfolioService(repo)
+	p := &model.Portfolio{Name: "Company Website (2024)", Description: "B", Tags: []string{" Go", "go", "Web", ""}}
+	if err := svc.Create(context.Background(), p); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if p.Slug != "company-website-2024" {
+		t.Errorf("expected slug from name, got %q", p.Slug)
+	}
+	if len(p.Tags) != 2 || p.Tags[0] != "go" || p.Tags[1] != "web" {
+		t.Errorf("expected normalised tags, got %v", p.Tags)
+	}
+
+	p = &model.Portfolio{Name: "A", Description: "B", Slug: "Not A Slug"}
+	if err := svc.Create(context.Background(), p); err == nil {
+		t.Error("expected an invalid slug to be rejected")
+	}
+}
+
+func TestPortfolioService_Create_UniqueSlug(t *testing.T) {
+	repo := &mockPortfolioRepo{
+		CreateFunc: func(ctx context.Context, p *model.Portfolio) error { return nil },
+		Slugs:      map[string]int{"shop": 1, "shop-2": 2, "project": 3},
+	}
+	svc := NewPortfolioService(repo)
+	for name, want := range map[string]string{"Shop": "shop-3", "Toko Ωλ": "toko", "Ωλ": "project-2"} {
+		p := &model.Portfolio{Name: name, Description: "B"}
+		if err := svc.Create(context.Background(), p); err != nil {
+			t.Fatalf("%s: expected no error, got %v", name, err)
+		}
+		if p.Slug != want {
+			t.Errorf("%s: expected slug %q, got %q", name, want, p.Slug)
+		}
+	}
+
+	// a project may keep the slug it already has
+	p := &model.Portfolio{ID: 2, Name: "Shop", Description: "B"}
+	if err := svc.Update(context.Background(), p); err != nil || p.Slug != "shop-2" {
+		t.Errorf("expected slug shop-2, got %q, err %v", p.Slug, err)
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"porto/model"
+	"porto/repository"
+	"porto/tracing"
+	"porto/validation"
+)
+
+type ProfileService interface {
+	// Get returns the profile, or an empty one before any has been saved.
+	Get(ctx context.Context) (*model.Profile, error)
+	Update(ctx context.Context, p *model.Profile) error
+}
+
+type profileService struct {
+	repo repository.ProfileRepository
+}
+
+func NewProfileService(repo repository.ProfileRepository) ProfileService {
+	return &profileService{repo}
+}
+
+func (s *profileService) Get(ctx context.Context) (*model.Profile, error) {
+	ctx, span := tracing.Start(ctx, "ProfileService.Get")
+	defer span.End()
+	p, err := s.repo.Get(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &model.Profile{Availability: model.AvailabilityAvailable}, nil
+	}
+	return p, err
+}
+
+func (s *profileService) Update(ctx context.Context, p *model.Profile) error {
+	ctx, span := tracing.Start(ctx, "ProfileService.Update")
+	defer span.End()
+	if p.Availability == "" {
+		p.Availability = model.AvailabilityAvailable
+	}
+	if err := validation.ValidateProfile(p); err != nil {
+		slog.WarnContext(ctx, "Update validation error", "component", "ProfileService", "error", err)
+		return err
+	}
+	if err := s.repo.Save(ctx, p); err != nil {
+		slog.ErrorContext(ctx, "Update DB error", "component", "ProfileService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Updated profile", "component", "ProfileService")
+	return nil
+}
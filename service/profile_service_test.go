@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"porto/model"
+	"testing"
+)
+
+type mockProfileRepo struct {
+	GetFunc  func(ctx context.Context) (*model.Profile, error)
+	SaveFunc func(ctx context.Context, p *model.Profile) error
+}
+
+func (m *mockProfileRepo) Get(ctx context.Context) (*model.Profile, error) {
+	return m.GetFunc(ctx)
+}
+func (m *mockProfileRepo) Save(ctx context.Context, p *model.Profile) error {
+	return m.SaveFunc(ctx, p)
+}
+
+func TestProfileService_Get_NotSaved(t *testing.T) {
+	repo := &mockProfileRepo{GetFunc: func(ctx context.Context) (*model.Profile, error) { return nil, sql.ErrNoRows }}
+	p, err := NewProfileService(repo).Get(context.Background())
+	if err != nil || p.Availability != model.AvailabilityAvailable {
+		t.Errorf("expected an empty available profile, got %+v, err %v", p, err)
+	}
+}
+
+func TestProfileService_Update(t *testing.T) {
+	var saved bool
+	repo := &mockProfileRepo{SaveFunc: func(ctx context.Context, p *model.Profile) error {
+		saved = true
+		return nil
+	}}
+	svc := NewProfileService(repo)
+
+	// valid; availability defaults to available
+	p := &model.Profile{Name: "A"}
+	if err := svc.Update(context.Background(), p); err != nil || !saved {
+		t.Fatalf("expected profile to be saved, got %v", err)
+	}
+	if p.Availability != model.AvailabilityAvailable {
+		t.Errorf("expected availability to default, got %q", p.Availability)
+	}
+
+	// invalid
+	saved = false
+	if err := svc.Update(context.Background(), &model.Profile{Name: "A", Availability: "away"}); err == nil || saved {
+		t.Error("expected error for unknown availability")
+	}
+}
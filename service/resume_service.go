@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"porto/model"
+	"porto/repository"
+	"porto/tracing"
+	"porto/validation"
+)
+
+type ResumeService interface {
+	// Import validates every entry before storing any of them, then upserts
+	// them in one transaction. Projects are matched by slug; one without a
+	// slug gets it from its name. Tags are normalised as in PortfolioService.
+	Import(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error)
+}
+
+type resumeService struct {
+	repo repository.ResumeRepository
+}
+
+func NewResumeService(repo repository.ResumeRepository) ResumeService {
+	return &resumeService{repo}
+}
+
+func (s *resumeService) Import(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error) {
+	ctx, span := tracing.Start(ctx, "ResumeService.Import")
+	defer span.End()
+	for i := range exps {
+		if err := validation.ValidateExperience(&exps[i]); err != nil {
+			slog.WarnContext(ctx, "Import validation error", "component", "ResumeService", "error", err)
+			return nil, fmt.Errorf("experience %d: %w", i, err)
+		}
+	}
+	for i := range projects {
+		normalizePortfolio(&projects[i])
+		if projects[i].Slug == "" {
+			projects[i].Slug = slugify(projects[i].Name)
+		}
+		if err := validation.ValidatePortfolio(&projects[i]); err != nil {
+			slog.WarnContext(ctx, "Import validation error", "component", "ResumeService", "error", err)
+			return nil, fmt.Errorf("project %d: %w", i, err)
+		}
+	}
+	result, err := s.repo.Import(ctx, exps, projects)
+	if err != nil {
+		slog.ErrorContext(ctx, "Import DB error", "component", "ResumeService", "error", err)
+		return nil, err
+	}
+	slog.InfoContext(ctx, "Imported resume", "component", "ResumeService",
+		"experiences_created", result.ExperiencesCreated, "experiences_updated", result.ExperiencesUpdated,
+		"projects_created", result.ProjectsCreated, "projects_updated", result.ProjectsUpdated)
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"porto/model"
+	"testing"
+	"time"
+)
+
+type mockResumeRepo struct {
+	ImportFunc func(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error)
+}
+
+func (m *mockResumeRepo) Import(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error) {
+	return m.ImportFunc(ctx, exps, projects)
+}
+
+func TestResumeService_Import(t *testing.T) {
+	var calls int
+	var gotProjects []model.Portfolio
+	svc := NewResumeService(&mockResumeRepo{
+		ImportFunc: func(ctx context.Context, exps []model.Experience, projects []model.Portfolio) (*model.ResumeImport, error) {
+			calls++
+			gotProjects = projects
+			return &model.ResumeImport{ExperiencesCreated: len(exps), ProjectsCreated: len(projects)}, nil
+		},
+	})
+	exp := model.Experience{Title: "Engineer", Company: "Acme", StartDate: model.NewDate(2022, time.March, 1), IsCurrent: true}
+
+	// valid; slugs and tags are normalised
+	result, err := svc.Import(context.Background(), []model.Experience{exp}, []model.Portfolio{{Name: "Online Shop", Description: "d", Tags: []string{"Go", "go"}}})
+	if err != nil || result.ExperiencesCreated != 1 || result.ProjectsCreated != 1 {
+		t.Fatalf("unexpected result %+v, err %v", result, err)
+	}
+	if gotProjects[0].Slug != "online-shop" || len(gotProjects[0].Tags) != 1 {
+		t.Errorf("expected a normalised project, got %+v", gotProjects[0])
+	}
+
+	// one invalid entry stores nothing
+	invalid := exp
+	invalid.Company = ""
+	_, err = svc.Import(context.Background(), []model.Experience{exp, invalid}, nil)
+	if err == nil {
+		t.Error("expected validation error")
+	}
+	if calls != 1 {
+		t.Errorf("expected the repository not to be called for invalid input, got %d calls", calls)
+	}
+}
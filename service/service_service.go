@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"porto/model"
+	"porto/repository"
+	"porto/validation"
+)
+
+type ServiceService interface {
+	GetAll(ctx context.Context) ([]model.Service, error)
+	GetByID(ctx context.Context, id int) (*model.Service, error)
+	Create(ctx context.Context, s *model.Service) error
+	Update(ctx context.Context, s *model.Service) error
+	Delete(ctx context.Context, id int) error
+	Reorder(ctx context.Context, ids []int) error
+}
+
+type serviceService struct {
+	repo repository.ServiceRepository
+}
+
+func NewServiceService(repo repository.ServiceRepository) ServiceService {
+	return &serviceService{repo}
+}
+
+func (s *serviceService) GetAll(ctx context.Context) ([]model.Service, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *serviceService) GetByID(ctx context.Context, id int) (*model.Service, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *serviceService) Create(ctx context.Context, svc *model.Service) error {
+	if err := validation.ValidateService(svc); err != nil {
+		slog.WarnContext(ctx, "Create validation error", "component", "ServiceService", "error", err)
+		return err
+	}
+	err := s.repo.Create(ctx, svc)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create DB error", "component", "ServiceService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Created service", "component", "ServiceService", "id", svc.ID)
+	return nil
+}
+
+func (s *serviceService) Update(ctx context.Context, svc *model.Service) error {
+	if svc.ID == 0 {
+		slog.WarnContext(ctx, "Update error: id is required", "component", "ServiceService")
+		return errors.New("id is required")
+	}
+	if err := validation.ValidateService(svc); err != nil {
+		slog.WarnContext(ctx, "Update validation error", "component", "ServiceService", "error", err)
+		return err
+	}
+	err := s.repo.Update(ctx, svc)
+	if err != nil {
+		slog.ErrorContext(ctx, "Update DB error", "component", "ServiceService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Updated service", "component", "ServiceService", "id", svc.ID)
+	return nil
+}
+
+func (s *serviceService) Delete(ctx context.Context, id int) error {
+	err := s.repo.Delete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Delete DB error", "component", "ServiceService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Deleted service", "component", "ServiceService", "id", id)
+	return nil
+}
+
+// Reorder sets the display order to match ids, first id first.
+func (s *serviceService) Reorder(ctx context.Context, ids []int) error {
+	if err := validateOrder(ids); err != nil {
+		return err
+	}
+	err := s.repo.Reorder(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Reorder DB error", "component", "ServiceService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Reordered services", "component", "ServiceService", "ids", ids)
+	return nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"porto/model"
+	"testing"
+)
+
+type mockServiceRepo struct {
+	CreateFunc  func(ctx context.Context, s *model.Service) error
+	ReorderFunc func(ctx context.Context, ids []int) error
+}
+
+func (m *mockServiceRepo) GetAll(ctx context.Context) ([]model.Service, error) { return nil, nil }
+func (m *mockServiceRepo) GetByID(ctx context.Context, id int) (*model.Service, error) {
+	return nil, nil
+}
+func (m *mockServiceRepo) Create(ctx context.Context, s *model.Service) error {
+	return m.CreateFunc(ctx, s)
+}
+func (m *mockServiceRepo) Update(ctx context.Context, s *model.Service) error { return nil }
+func (m *mockServiceRepo) Delete(ctx context.Context, id int) error           { return nil }
+func (m *mockServiceRepo) Reorder(ctx context.Context, ids []int) error {
+	return m.ReorderFunc(ctx, ids)
+}
+
+func TestServiceService_Create(t *testing.T) {
+	repo := &mockServiceRepo{
+		CreateFunc: func(ctx context.Context, s *model.Service) error {
+			if s.Title == "exists" {
+				return errors.New("duplicate")
+			}
+			return nil
+		},
+	}
+	svc := NewServiceService(repo)
+
+	// valid
+	err := svc.Create(context.Background(), &model.Service{Title: "Web design", Description: "B"})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	// invalid
+	err = svc.Create(context.Background(), &model.Service{Title: "", Description: "B"})
+	if err == nil {
+		t.Errorf("expected error for empty title")
+	}
+
+	// duplicate
+	err = svc.Create(context.Background(), &model.Service{Title: "exists", Description: "B"})
+	if err == nil || err.Error() != "duplicate" {
+		t.Errorf("expected duplicate error, got %v", err)
+	}
+}
+
+func TestServiceService_Reorder(t *testing.T) {
+	var got []int
+	repo := &mockServiceRepo{ReorderFunc: func(ctx context.Context, ids []int) error {
+		got = ids
+		return nil
+	}}
+	svc := NewServiceService(repo)
+
+	if err := svc.Reorder(context.Background(), []int{3, 1, 2}); err != nil || len(got) != 3 {
+		t.Errorf("expected reorder to reach repo, got %v, err %v", got, err)
+	}
+	if err := svc.Reorder(context.Background(), nil); err == nil {
+		t.Error("expected error for empty ids")
+	}
+	if err := svc.Reorder(context.Background(), []int{1, 1}); err == nil {
+		t.Error("expected error for duplicate ids")
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"porto/model"
+	"porto/repository"
+	"porto/validation"
+	"strings"
+)
+
+type SkillService interface {
+	GetAll(ctx context.Context) ([]model.Skill, error)
+	GetByID(ctx context.Context, id int) (*model.Skill, error)
+	Create(ctx context.Context, s *model.Skill) error
+	Update(ctx context.Context, s *model.Skill) error
+	Delete(ctx context.Context, id int) error
+	// GetUsage returns the skills matrix. A non-empty name narrows it to the
+	// matching skill (case-insensitive), e.g. "which projects used Go".
+	GetUsage(ctx context.Context, name string) ([]model.SkillUsage, error)
+}
+
+type skillService struct {
+	repo repository.SkillRepository
+}
+
+func NewSkillService(repo repository.SkillRepository) SkillService {
+	return &skillService{repo}
+}
+
+func (s *skillService) GetAll(ctx context.Context) ([]model.Skill, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *skillService) GetByID(ctx context.Context, id int) (*model.Skill, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *skillService) Create(ctx context.Context, sk *model.Skill) error {
+	if err := validation.ValidateSkill(sk); err != nil {
+		slog.WarnContext(ctx, "Create validation error", "component", "SkillService", "error", err)
+		return err
+	}
+	err := s.repo.Create(ctx, sk)
+	if err != nil {
+		slog.ErrorContext(ctx, "Create DB error", "component", "SkillService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Created skill", "component", "SkillService", "id", sk.ID)
+	return nil
+}
+
+func (s *skillService) Update(ctx context.Context, sk *model.Skill) error {
+	if sk.ID == 0 {
+		slog.WarnContext(ctx, "Update error: id is required", "component", "SkillService")
+		return errors.New("id is required")
+	}
+	if err := validation.ValidateSkill(sk); err != nil {
+		slog.WarnContext(ctx, "Update validation error", "component", "SkillService", "error", err)
+		return err
+	}
+	err := s.repo.Update(ctx, sk)
+	if err != nil {
+		slog.ErrorContext(ctx, "Update DB error", "component", "SkillService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Updated skill", "component", "SkillService", "id", sk.ID)
+	return nil
+}
+
+func (s *skillService) Delete(ctx context.Context, id int) error {
+	err := s.repo.Delete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Delete DB error", "component", "SkillService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Deleted skill", "component", "SkillService", "id", id)
+	return nil
+}
+
+func (s *skillService) GetUsage(ctx context.Context, name string) ([]model.SkillUsage, error) {
+	usage, err := s.repo.GetUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return usage, nil
+	}
+	var filtered []model.SkillUsage
+	for _, u := range usage {
+		if strings.EqualFold(u.Name, name) {
+			filtered = append(filtered, u)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"porto/model"
+	"testing"
+)
+
+type mockSkillRepo struct {
+	CreateFunc   func(ctx context.Context, s *model.Skill) error
+	GetUsageFunc func(ctx context.Context) ([]model.SkillUsage, error)
+}
+
+func (m *mockSkillRepo) GetAll(ctx context.Context) ([]model.Skill, error) { return nil, nil }
+func (m *mockSkillRepo) GetByID(ctx context.Context, id int) (*model.Skill, error) {
+	return nil, nil
+}
+func (m *mockSkillRepo) Create(ctx context.Context, s *model.Skill) error {
+	return m.CreateFunc(ctx, s)
+}
+func (m *mockSkillRepo) Update(ctx context.Context, s *model.Skill) error { return nil }
+func (m *mockSkillRepo) Delete(ctx context.Context, id int) error         { return nil }
+func (m *mockSkillRepo) GetUsage(ctx context.Context) ([]model.SkillUsage, error) {
+	return m.GetUsageFunc(ctx)
+}
+
+func TestSkillService_Create(t *testing.T) {
+	repo := &mockSkillRepo{
+		CreateFunc: func(ctx context.Context, s *model.Skill) error {
+			if s.Name == "exists" {
+				return errors.New("duplicate")
+			}
+			return nil
+		},
+	}
+	svc := NewSkillService(repo)
+
+	// valid
+	err := svc.Create(context.Background(), &model.Skill{Name: "Go", Proficiency: model.ProficiencyExpert})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	// invalid
+	err = svc.Create(context.Background(), &model.Skill{Name: "Go", Proficiency: "guru"})
+	if err == nil {
+		t.Errorf("expected error for unknown proficiency")
+	}
+
+	// duplicate
+	err = svc.Create(context.Background(), &model.Skill{Name: "exists", Proficiency: model.ProficiencyExpert})
+	if err == nil || err.Error() != "duplicate" {
+		t.Errorf("expected duplicate error, got %v", err)
+	}
+}
+
+func TestSkillService_Update_Invalid(t *testing.T) {
+	svc := NewSkillService(&mockSkillRepo{})
+	err := svc.Update(context.Background(), &model.Skill{Name: "Go", Proficiency: model.ProficiencyExpert})
+	if err == nil {
+		t.Error("expected error for missing id")
+	}
+}
+
+func TestSkillService_GetUsage_FilterByName(t *testing.T) {
+	repo := &mockSkillRepo{GetUsageFunc: func(ctx context.Context) ([]model.SkillUsage, error) {
+		return []model.SkillUsage{
+			{Skill: model.Skill{ID: 1, Name: "Go"}, Projects: []model.Portfolio{{ID: 10}}},
+			{Skill: model.Skill{ID: 2, Name: "CSS"}},
+		}, nil
+	}}
+	svc := NewSkillService(repo)
+
+	all, err := svc.GetUsage(context.Background(), "")
+	if err != nil || len(all) != 2 {
+		t.Errorf("expected 2 results, got %v, err %v", all, err)
+	}
+	goOnly, err := svc.GetUsage(context.Background(), "go")
+	if err != nil || len(goOnly) != 1 || goOnly[0].ID != 1 {
+		t.Errorf("expected only Go, got %v, err %v", goOnly, err)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"porto/mailer"
+	"porto/metrics"
+	"porto/model"
+	"porto/repository"
+	"porto/validation"
+	"strings"
+	"time"
+)
+
+type SubscriberService interface {
+	// Subscribe records a pending subscription and e-mails a confirmation
+	// link. Subscribing an already confirmed address is a no-op.
+	Subscribe(ctx context.Context, email string) error
+	Confirm(ctx context.Context, token string) error
+	Unsubscribe(ctx context.Context, token string) error
+	GetByStatus(ctx context.Context, status string) ([]model.Subscriber, error)
+}
+
+type subscriberService struct {
+	repo    repository.SubscriberRepository
+	mailer  mailer.Mailer
+	baseURL string
+	from    string
+	now     func() time.Time
+}
+
+// NewSubscriberService builds confirmation links from baseURL, e.g.
+// "https://example.com", and sends them from the given address.
+func NewSubscriberService(repo repository.SubscriberRepository, m mailer.Mailer, baseURL, from string) SubscriberService {
+	return &subscriberService{repo: repo, mailer: m, baseURL: strings.TrimRight(baseURL, "/"), from: from, now: time.Now}
+}
+
+func (s *subscriberService) Subscribe(ctx context.Context, email string) error {
+	sub := &model.Subscriber{Email: strings.ToLower(strings.TrimSpace(email))}
+	if err := validation.ValidateSubscriber(sub); err != nil {
+		slog.WarnContext(ctx, "Subscribe validation error", "component", "SubscriberService", "error", err)
+		return err
+	}
+
+	existing, err := s.repo.GetByEmail(ctx, sub.Email)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		sub.Status = model.SubscriberPending
+		if sub.Token, err = randomName(); err != nil {
+			return err
+		}
+		if err := s.repo.Create(ctx, sub); err != nil {
+			slog.ErrorContext(ctx, "Subscribe DB error", "component", "SubscriberService", "error", err)
+			return err
+		}
+	case err != nil:
+		slog.ErrorContext(ctx, "Subscribe lookup error", "component", "SubscriberService", "error", err)
+		return err
+	case existing.Status == model.SubscriberConfirmed:
+		slog.InfoContext(ctx, "Subscribe: subscriber already confirmed", "component", "SubscriberService", "id", existing.ID)
+		return nil
+	default:
+		sub = existing
+		sub.Status = model.SubscriberPending
+		sub.ConfirmedAt = nil
+		if err := s.repo.UpdateStatus(ctx, sub); err != nil {
+			slog.ErrorContext(ctx, "Subscribe DB error", "component", "SubscriberService", "error", err)
+			return err
+		}
+	}
+
+	if err := s.mailer.Send(ctx, s.confirmationMessage(sub)); err != nil {
+		slog.ErrorContext(ctx, "Subscribe mail error", "component", "SubscriberService", "error", err)
+		return err
+	}
+	metrics.NewsletterSubscriptions.WithLabelValues(model.SubscriberPending).Inc()
+	slog.InfoContext(ctx, "Confirmation sent to subscriber", "component", "SubscriberService", "id", sub.ID)
+	return nil
+}
+
+func (s *subscriberService) Confirm(ctx context.Context, token string) error {
+	sub, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	if sub.Status == model.SubscriberConfirmed {
+		return nil
+	}
+	now := s.now()
+	sub.Status = model.SubscriberConfirmed
+	sub.ConfirmedAt = &now
+	if err := s.repo.UpdateStatus(ctx, sub); err != nil {
+		slog.ErrorContext(ctx, "Confirm DB error", "component", "SubscriberService", "error", err)
+		return err
+	}
+	metrics.NewsletterSubscriptions.WithLabelValues(model.SubscriberConfirmed).Inc()
+	slog.InfoContext(ctx, "Confirmed subscriber", "component", "SubscriberService", "id", sub.ID)
+	return nil
+}
+
+func (s *subscriberService) Unsubscribe(ctx context.Context, token string) error {
+	sub, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	sub.Status = model.SubscriberUnsubscribed
+	if err := s.repo.UpdateStatus(ctx, sub); err != nil {
+		slog.ErrorContext(ctx, "Unsubscribe DB error", "component", "SubscriberService", "error", err)
+		return err
+	}
+	metrics.NewsletterSubscriptions.WithLabelValues(model.SubscriberUnsubscribed).Inc()
+	slog.InfoContext(ctx, "Unsubscribed subscriber", "component", "SubscriberService", "id", sub.ID)
+	return nil
+}
+
+func (s *subscriberService) GetByStatus(ctx context.Context, status string) ([]model.Subscriber, error) {
+	switch status {
+	case model.SubscriberPending, model.SubscriberConfirmed, model.SubscriberUnsubscribed:
+		return s.repo.GetByStatus(ctx, status)
+	default:
+		return nil, errors.New("unknown subscriber status: " + status)
+	}
+}
+
+func (s *subscriberService) confirmationMessage(sub *model.Subscriber) mailer.Message {
+	token := url.QueryEscape(sub.Token)
+	confirm := s.baseURL + "/newsletter/confirm?token=" + token
+	unsubscribe := s.baseURL + "/newsletter/unsubscribe?token=" + token
+	return mailer.Message{
+		From:    s.from,
+		To:      []string{sub.Email},
+		Subject: "Please confirm your subscription",
+		Text: fmt.Sprintf("Thanks for subscribing!\n\nConfirm your subscription:\n%s\n\n"+
+			"If you did not ask for this, ignore this e-mail or unsubscribe:\n%s\n", confirm, unsubscribe),
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"porto/mailer"
+	"porto/model"
+	"strings"
+	"testing"
+)
+
+type mockSubscriberRepo struct {
+	byEmail map[string]*model.Subscriber
+	updated []model.Subscriber
+}
+
+func newMockSubscriberRepo() *mockSubscriberRepo {
+	return &mockSubscriberRepo{byEmail: map[string]*model.Subscriber{}}
+}
+
+func (m *mockSubscriberRepo) GetByStatus(ctx context.Context, status string) ([]model.Subscriber, error) {
+	return nil, nil
+}
+func (m *mockSubscriberRepo) GetByEmail(ctx context.Context, email string) (*model.Subscriber, error) {
+	if s, ok := m.byEmail[email]; ok {
+		return s, nil
+	}
+	return nil, sql.ErrNoRows
+}
+func (m *mockSubscriberRepo) GetByToken(ctx context.Context, token string) (*model.Subscriber, error) {
+	for _, s := range m.byEmail {
+		if s.Token == token {
+			return s, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+func (m *mockSubscriberRepo) Create(ctx context.Context, s *model.Subscriber) error {
+	s.ID = len(m.byEmail) + 1
+	m.byEmail[s.Email] = s
+	return nil
+}
+func (m *mockSubscriberRepo) UpdateStatus(ctx context.Context, s *model.Subscriber) error {
+	m.updated = append(m.updated, *s)
+	return nil
+}
+
+type recordingMailer struct {
+	sent []mailer.Message
+}
+
+func (m *recordingMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestSubscriberService_DoubleOptIn(t *testing.T) {
+	repo := newMockSubscriberRepo()
+	mail := &recordingMailer{}
+	svc := NewSubscriberService(repo, mail, "https://example.com/", "site@example.com")
+	ctx := context.Background()
+
+	if err := svc.Subscribe(ctx, " A@Mail.com "); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	sub := repo.byEmail["a@mail.com"]
+	if sub == nil || sub.Status != model.SubscriberPending || sub.Token == "" {
+		t.Fatalf("expected pending subscriber with token, got %+v", sub)
+	}
+	if len(mail.sent) != 1 || !strings.Contains(mail.sent[0].Text, "https://example.com/newsletter/confirm?token="+sub.Token) {
+		t.Fatalf("expected confirmation mail with link, got %+v", mail.sent)
+	}
+
+	if err := svc.Confirm(ctx, sub.Token); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if sub.Status != model.SubscriberConfirmed || sub.ConfirmedAt == nil {
+		t.Errorf("expected confirmed subscriber, got %+v", sub)
+	}
+
+	// subscribing again once confirmed sends nothing
+	if err := svc.Subscribe(ctx, "a@mail.com"); err != nil || len(mail.sent) != 1 {
+		t.Errorf("expected no new mail, got %d mails, err %v", len(mail.sent), err)
+	}
+
+	if err := svc.Unsubscribe(ctx, sub.Token); err != nil || sub.Status != model.SubscriberUnsubscribed {
+		t.Errorf("expected unsubscribed, got %+v, err %v", sub, err)
+	}
+}
+
+func TestSubscriberService_Subscribe_Invalid(t *testing.T) {
+	svc := NewSubscriberService(newMockSubscriberRepo(), &recordingMailer{}, "", "")
+	if err := svc.Subscribe(context.Background(), "not-an-email"); err == nil {
+		t.Error("expected error for invalid email")
+	}
+}
+
+func TestSubscriberService_Confirm_UnknownToken(t *testing.T) {
+	svc := NewSubscriberService(newMockSubscriberRepo(), &recordingMailer{}, "", "")
+	if err := svc.Confirm(context.Background(), "nope"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"porto/metrics"
+	"porto/model"
+	"porto/repository"
+	"porto/validation"
+)
+
+type TestimonialService interface {
+	// GetAll lists testimonials for moderation; an empty status lists all.
+	GetAll(ctx context.Context, status string) ([]model.Testimonial, error)
+	// GetApproved lists the testimonials that may be shown publicly.
+	GetApproved(ctx context.Context) ([]model.Testimonial, error)
+	GetByID(ctx context.Context, id int) (*model.Testimonial, error)
+	// Submit stores a public submission as pending, whatever status it carries.
+	Submit(ctx context.Context, t *model.Testimonial) error
+	Approve(ctx context.Context, id int) error
+	Reject(ctx context.Context, id int) error
+	Delete(ctx context.Context, id int) error
+}
+
+type testimonialService struct {
+	repo repository.TestimonialRepository
+}
+
+func NewTestimonialService(repo repository.TestimonialRepository) TestimonialService {
+	return &testimonialService{repo}
+}
+
+func (s *testimonialService) GetAll(ctx context.Context, status string) ([]model.Testimonial, error) {
+	switch status {
+	case "":
+		return s.repo.GetAll(ctx)
+	case model.TestimonialPending, model.TestimonialApproved, model.TestimonialRejected:
+		return s.repo.GetByStatus(ctx, status)
+	default:
+		return nil, errors.New("unknown testimonial status: " + status)
+	}
+}
+
+func (s *testimonialService) GetApproved(ctx context.Context) ([]model.Testimonial, error) {
+	return s.repo.GetByStatus(ctx, model.TestimonialApproved)
+}
+
+func (s *testimonialService) GetByID(ctx context.Context, id int) (*model.Testimonial, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *testimonialService) Submit(ctx context.Context, t *model.Testimonial) error {
+	if err := validation.ValidateTestimonial(t); err != nil {
+		slog.WarnContext(ctx, "Submit validation error", "component", "TestimonialService", "error", err)
+		return err
+	}
+	t.Status = model.TestimonialPending
+	err := s.repo.Create(ctx, t)
+	if err != nil {
+		slog.ErrorContext(ctx, "Submit DB error", "component", "TestimonialService", "error", err)
+		return err
+	}
+	metrics.TestimonialsSubmitted.Inc()
+	slog.InfoContext(ctx, "Submitted testimonial", "component", "TestimonialService", "id", t.ID)
+	return nil
+}
+
+func (s *testimonialService) Approve(ctx context.Context, id int) error {
+	return s.setStatus(ctx, id, model.TestimonialApproved)
+}
+
+func (s *testimonialService) Reject(ctx context.Context, id int) error {
+	return s.setStatus(ctx, id, model.TestimonialRejected)
+}
+
+func (s *testimonialService) setStatus(ctx context.Context, id int, status string) error {
+	err := s.repo.UpdateStatus(ctx, id, status)
+	if err != nil {
+		slog.ErrorContext(ctx, "UpdateStatus DB error", "component", "TestimonialService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Updated testimonial status", "component", "TestimonialService", "id", id, "status", status)
+	return nil
+}
+
+func (s *testimonialService) Delete(ctx context.Context, id int) error {
+	err := s.repo.Delete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Delete DB error", "component", "TestimonialService", "error", err)
+		return err
+	}
+	slog.InfoContext(ctx, "Deleted testimonial", "component", "TestimonialService", "id", id)
+	return nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"porto/model"
+	"testing"
+)
+
+type mockTestimonialRepo struct {
+	GetByStatusFunc  func(ctx context.Context, status string) ([]model.Testimonial, error)
+	CreateFunc       func(ctx context.Context, t *model.Testimonial) error
+	UpdateStatusFunc func(ctx context.Context, id int, status string) error
+}
+
+func (m *mockTestimonialRepo) GetAll(ctx context.Context) ([]model.Testimonial, error) {
+	return nil, nil
+}
+func (m *mockTestimonialRepo) GetByStatus(ctx context.Context, status string) ([]model.Testimonial, error) {
+	return m.GetByStatusFunc(ctx, status)
+}
+func (m *mockTestimonialRepo) GetByID(ctx context.Context, id int) (*model.Testimonial, error) {
+	return nil, nil
+}
+func (m *mockTestimonialRepo) Create(ctx context.Context, t *model.Testimonial) error {
+	return m.CreateFunc(ctx, t)
+}
+func (m *mockTestimonialRepo) UpdateStatus(ctx context.Context, id int, status string) error {
+	return m.UpdateStatusFunc(ctx, id, status)
+}
+func (m *mockTestimonialRepo) Delete(ctx context.Context, id int) error { return nil }
+
+func TestTestimonialService_Submit_ForcesPending(t *testing.T) {
+	var stored model.Testimonial
+	repo := &mockTestimonialRepo{CreateFunc: func(ctx context.Context, t *model.Testimonial) error {
+		stored = *t
+		return nil
+	}}
+	svc := NewTestimonialService(repo)
+
+	tm := &model.Testimonial{Author: "A", Quote: "Great", Rating: 5, Status: model.TestimonialApproved}
+	if err := svc.Submit(context.Background(), tm); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stored.Status != model.TestimonialPending {
+		t.Errorf("expected pending status, got %q", stored.Status)
+	}
+
+	// invalid
+	if err := svc.Submit(context.Background(), &model.Testimonial{Author: "A", Quote: "Great"}); err == nil {
+		t.Error("expected error for missing rating")
+	}
+}
+
+func TestTestimonialService_Moderation(t *testing.T) {
+	var gotID int
+	var gotStatus string
+	repo := &mockTestimonialRepo{UpdateStatusFunc: func(ctx context.Context, id int, status string) error {
+		gotID, gotStatus = id, status
+		return nil
+	}}
+	svc := NewTestimonialService(repo)
+
+	if err := svc.Approve(context.Background(), 3); err != nil || gotID != 3 || gotStatus != model.TestimonialApproved {
+		t.Errorf("expected approve of 3, got %d %q, err %v", gotID, gotStatus, err)
+	}
+	if err := svc.Reject(context.Background(), 4); err != nil || gotID != 4 || gotStatus != model.TestimonialRejected {
+		t.Errorf("expected reject of 4, got %d %q, err %v", gotID, gotStatus, err)
+	}
+}
+
+func TestTestimonialService_GetAll_UnknownStatus(t *testing.T) {
+	svc := NewTestimonialService(&mockTestimonialRepo{})
+	if _, err := svc.GetAll(context.Background(), "archived"); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
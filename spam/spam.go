@@ -0,0 +1,194 @@
+// Package spam screens anonymous form submissions with local checks only:
+// a honeypot field, a signed form timestamp, rate limits and a content score.
+package spam
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned when an IP or e-mail address submits too often.
+var ErrRateLimited = errors.New("too many submissions, please try again later")
+
+// Submission carries the request details the checks need besides the
+// message itself.
+type Submission struct {
+	IP string
+	// Honeypot is the value of a hidden field that people leave empty.
+	Honeypot string
+	// Token is the signed timestamp issued with the form. API clients that
+	// predate it may leave it out; it is checked when sent or when
+	// RequireToken is set, as it is for the site's own form.
+	Token        string
+	RequireToken bool
+}
+
+// Verdict is the outcome of Check. Spam submissions are still stored, but
+// kept out of the inbox.
+type Verdict struct {
+	Spam    bool
+	Score   int
+	Reasons []string
+}
+
+type Config struct {
+	Secret []byte
+	// MinSubmitTime is the least time a person needs to fill in the form.
+	MinSubmitTime time.Duration
+	// MaxFormAge rejects tokens from forms left open for too long.
+	MaxFormAge time.Duration
+	IPLimit    int
+	EmailLimit int
+	// Window is the period the IP and e-mail limits apply to.
+	Window time.Duration
+	// Threshold is the score at which a submission counts as spam.
+	Threshold int
+}
+
+// DefaultConfig returns sensible limits for a personal site.
+func DefaultConfig(secret []byte) Config {
+	return Config{
+		Secret:        secret,
+		MinSubmitTime: 3 * time.Second,
+		MaxFormAge:    24 * time.Hour,
+		IPLimit:       5,
+		EmailLimit:    3,
+		Window:        time.Hour,
+		Threshold:     5,
+	}
+}
+
+type Guard struct {
+	cfg    Config
+	ips    *limiter
+	emails *limiter
+	now    func() time.Time
+}
+
+func NewGuard(cfg Config) *Guard {
+	return &Guard{cfg: cfg, ips: newLimiter(cfg.IPLimit, cfg.Window), emails: newLimiter(cfg.EmailLimit, cfg.Window), now: time.Now}
+}
+
+// Token returns a form token signed with the current time.
+func (g *Guard) Token() string {
+	ts := strconv.FormatInt(g.now().Unix(), 10)
+	return ts + "." + g.sign(ts)
+}
+
+func (g *Guard) sign(ts string) string {
+	mac := hmac.New(sha256.New, g.cfg.Secret)
+	mac.Write([]byte(ts))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Check scores a submission. It returns ErrRateLimited when the sender is
+// over a limit; otherwise the verdict says whether the message is spam.
+func (g *Guard) Check(sub Submission, email string, text ...string) (Verdict, error) {
+	now := g.now()
+	if !g.ips.allow(sub.IP, now) || !g.emails.allow(strings.ToLower(email), now) {
+		return Verdict{}, ErrRateLimited
+	}
+
+	var v Verdict
+	flag := func(points int, reason string) {
+		v.Score += points
+		v.Reasons = append(v.Reasons, reason)
+	}
+	if sub.Honeypot != "" {
+		flag(g.cfg.Threshold, "honeypot")
+	}
+	if sub.Token != "" || sub.RequireToken {
+		if reason := g.checkToken(sub.Token, now); reason != "" {
+			flag(g.cfg.Threshold, reason)
+		}
+	}
+	content := strings.Join(text, "\n")
+	if n := len(linkPattern.FindAllString(content, -1)); n > 1 {
+		flag(2*(n-1), "links")
+	}
+	lower := strings.ToLower(content)
+	for _, word := range keywords {
+		if strings.Contains(lower, word) {
+			flag(3, "keyword:"+word)
+		}
+	}
+	v.Spam = v.Score >= g.cfg.Threshold
+	return v, nil
+}
+
+func (g *Guard) checkToken(token string, now time.Time) string {
+	ts, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(g.sign(ts))) {
+		return "invalid token"
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return "invalid token"
+	}
+	age := now.Sub(time.Unix(unix, 0))
+	switch {
+	case age < g.cfg.MinSubmitTime:
+		return "submitted too fast"
+	case age > g.cfg.MaxFormAge:
+		return "expired token"
+	}
+	return ""
+}
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+`)
+
+var keywords = []string{"viagra", "casino", "crypto", "bitcoin", "forex", "backlinks", "seo services", "loan offer", "payday"}
+
+// maxLimiterKeys bounds memory before stale keys are dropped.
+const maxLimiterKeys = 10000
+
+// limiter allows up to limit events per key within window.
+type limiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	events map[string][]time.Time
+}
+
+func newLimiter(limit int, window time.Duration) *limiter {
+	return &limiter{limit: limit, window: window, events: map[string][]time.Time{}}
+}
+
+func (l *limiter) allow(key string, now time.Time) bool {
+	if key == "" || l.limit <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cutoff := now.Add(-l.window)
+	if len(l.events) > maxLimiterKeys {
+		l.prune(cutoff)
+	}
+	recent := l.events[key][:0]
+	for _, t := range l.events[key] {
+		if t.After(cutoff) {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= l.limit {
+		l.events[key] = recent
+		return false
+	}
+	l.events[key] = append(recent, now)
+	return true
+}
+
+func (l *limiter) prune(cutoff time.Time) {
+	for key, events := range l.events {
+		if len(events) == 0 || !events[len(events)-1].After(cutoff) {
+			delete(l.events, key)
+		}
+	}
+}
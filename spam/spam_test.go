@@ -0,0 +1,85 @@
+package spam
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestGuard(now *time.Time) *Guard {
+	g := NewGuard(DefaultConfig([]byte("secret")))
+	g.now = func() time.Time { return *now }
+	return g
+}
+
+func TestGuard_Check(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+	token := g.Token()
+	now = now.Add(time.Minute)
+
+	cases := []struct {
+		name     string
+		sub      Submission
+		text     string
+		wantSpam bool
+	}{
+		{"clean", Submission{IP: "1", Token: token}, "Hi, I'd like to work with you.", false},
+		{"honeypot", Submission{IP: "2", Token: token, Honeypot: "http://spam"}, "hello", true},
+		{"missing token", Submission{IP: "3"}, "hello", false},
+		{"missing form token", Submission{IP: "7", RequireToken: true}, "hello", true},
+		{"forged token", Submission{IP: "4", Token: "1704110400.bogus"}, "hello", true},
+		{"links", Submission{IP: "5", Token: token}, "see http://a.test http://b.test www.c.test https://d.test", true},
+		{"keywords", Submission{IP: "6", Token: token}, "Cheap SEO services and backlinks", true},
+	}
+	for _, c := range cases {
+		v, err := g.Check(c.sub, c.name+"@mail.com", c.text)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", c.name, err)
+		}
+		if v.Spam != c.wantSpam {
+			t.Errorf("%s: expected spam=%v, got %+v", c.name, c.wantSpam, v)
+		}
+	}
+}
+
+func TestGuard_Check_Timing(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+	token := g.Token()
+
+	now = now.Add(time.Second)
+	if v, _ := g.Check(Submission{IP: "1", Token: token}, "a@mail.com", "hi"); !v.Spam {
+		t.Errorf("expected fast submission to be spam, got %+v", v)
+	}
+	now = now.Add(48 * time.Hour)
+	if v, _ := g.Check(Submission{IP: "2", Token: token}, "b@mail.com", "hi"); !v.Spam {
+		t.Errorf("expected expired token to be spam, got %+v", v)
+	}
+}
+
+func TestGuard_Check_RateLimit(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	g := newTestGuard(&now)
+
+	for i := 0; i < 3; i++ {
+		if _, err := g.Check(Submission{IP: "ip"}, "A@mail.com", "hi"); err != nil {
+			t.Fatalf("submission %d: expected no error, got %v", i, err)
+		}
+	}
+	if _, err := g.Check(Submission{IP: "other"}, "a@mail.com", "hi"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected e-mail limit, got %v", err)
+	}
+	if _, err := g.Check(Submission{IP: "ip"}, "b@mail.com", "hi"); err != nil {
+		t.Errorf("expected 4th submission from ip to pass, got %v", err)
+	}
+	g.Check(Submission{IP: "ip"}, "c@mail.com", "hi")
+	if _, err := g.Check(Submission{IP: "ip"}, "d@mail.com", "hi"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected IP limit, got %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := g.Check(Submission{IP: "ip"}, "a@mail.com", "hi"); err != nil {
+		t.Errorf("expected limits to reset after the window, got %v", err)
+	}
+}
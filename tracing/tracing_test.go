@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	rec := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return rec
+}
+
+func attr(s sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range s.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestMiddleware(t *testing.T) {
+	rec := recordSpans(t)
+	r := chi.NewRouter()
+	r.Use(Middleware)
+	r.Get("/api/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, span := Start(r.Context(), "PortfolioService.GetByID")
+		span.End()
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/projects/1", nil))
+
+	spans := rec.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	child, server := spans[0], spans[1]
+	if server.Name() != "GET /api/projects/{id}" || attr(server, "http.response.status_code").AsInt64() != 500 {
+		t.Errorf("unexpected server span %q %v", server.Name(), server.Attributes())
+	}
+	if child.Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Error("expected the service span to be a child of the request span")
+	}
+}
+
+type dsnConnector struct {
+	dsn string
+	drv driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) { return c.drv.Open(c.dsn) }
+func (c dsnConnector) Driver() driver.Driver                        { return c.drv }
+
+func TestWrapConnector(t *testing.T) {
+	rec := recordSpans(t)
+	mockDB, mock, err := sqlmock.NewWithDSN("tracing_test", sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mockDB.Close()
+	db := sql.OpenDB(WrapConnector(dsnConnector{"tracing_test", mockDB.Driver()}))
+	defer db.Close()
+
+	query := "SELECT id FROM portfolios"
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	rows, err := db.QueryContext(context.Background(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+
+	mock.ExpectExec("DELETE FROM portfolios WHERE id=$1").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM portfolios WHERE id=$1", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := rec.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	if attr(spans[0], "db.query.text").AsString() != query || attr(spans[0], rowsKey).AsInt64() != 2 {
+		t.Errorf("unexpected query span attributes %v", spans[0].Attributes())
+	}
+	if attr(spans[1], rowsKey).AsInt64() != 1 {
+		t.Errorf("unexpected exec span attributes %v", spans[1].Attributes())
+	}
+}
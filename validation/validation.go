@@ -1,51 +1,201 @@
 package validation
 
 import (
-	"errors"
+	"net/url"
+	"porto/i18n"
 	"porto/model"
 	"regexp"
+	"slices"
 	"strings"
 )
 
+// Error is a validation failure. Its message comes from the i18n catalog
+// under "validation."+Key; Error returns it in the default locale.
+type Error struct {
+	Key  string
+	Args []any
+}
+
+func newError(key string, args ...any) error {
+	return &Error{Key: key, Args: args}
+}
+
+func (e *Error) Error() string {
+	return e.Localize(i18n.Default)
+}
+
+// Localize returns the message in locale.
+func (e *Error) Localize(locale string) string {
+	return i18n.T(locale, "validation."+e.Key, e.Args...)
+}
+
+var validSlug = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 func ValidatePortfolio(p *model.Portfolio) error {
 	if strings.TrimSpace(p.Name) == "" {
-		return errors.New("portfolio name is required")
+		return newError("portfolio.name")
 	}
 	if strings.TrimSpace(p.Description) == "" {
-		return errors.New("portfolio description is required")
+		return newError("portfolio.description")
 	}
-	return nil
+	if !validSlug.MatchString(p.Slug) {
+		return newError("portfolio.slug")
+	}
+	return validateLocales(p.Translations)
 }
 
 func ValidateExperience(e *model.Experience) error {
 	if strings.TrimSpace(e.Title) == "" {
-		return errors.New("experience title is required")
+		return newError("experience.title")
 	}
 	if strings.TrimSpace(e.Company) == "" {
-		return errors.New("experience company is required")
+		return newError("experience.company")
+	}
+	if e.StartDate.IsZero() {
+		return newError("experience.start")
+	}
+	if e.IsCurrent && !e.EndDate.IsZero() {
+		return newError("experience.current_end")
+	}
+	if !e.IsCurrent && e.EndDate.IsZero() {
+		return newError("experience.end")
+	}
+	if !e.EndDate.IsZero() && e.EndDate.Before(e.StartDate.Time) {
+		return newError("experience.order")
+	}
+	return validateLocales(e.Translations)
+}
+
+func validateLocales[T any](translations model.Translations[T]) error {
+	for locale := range translations {
+		if !i18n.Supports(locale) {
+			return newError("translation.locale", strings.Join(i18n.Supported, ", "))
+		}
+	}
+	return nil
+}
+
+func ValidateSkill(s *model.Skill) error {
+	if strings.TrimSpace(s.Name) == "" {
+		return newError("skill.name")
+	}
+	if !slices.Contains(model.ProficiencyLevels, s.Proficiency) {
+		return newError("skill.proficiency", strings.Join(model.ProficiencyLevels, ", "))
+	}
+	if s.Years < 0 {
+		return newError("skill.years")
 	}
-	if strings.TrimSpace(e.StartDate) == "" {
-		return errors.New("start date is required")
+	return nil
+}
+
+func ValidateService(s *model.Service) error {
+	if strings.TrimSpace(s.Title) == "" {
+		return newError("service.title")
+	}
+	if strings.TrimSpace(s.Description) == "" {
+		return newError("service.description")
+	}
+	if s.SortOrder < 0 {
+		return newError("service.order")
+	}
+	return nil
+}
+
+func ValidateTestimonial(t *model.Testimonial) error {
+	if strings.TrimSpace(t.Author) == "" {
+		return newError("testimonial.author")
+	}
+	if strings.TrimSpace(t.Quote) == "" {
+		return newError("testimonial.quote")
+	}
+	if t.Rating < 1 || t.Rating > 5 {
+		return newError("testimonial.rating")
+	}
+	return nil
+}
+
+func ValidateClient(c *model.Client) error {
+	if strings.TrimSpace(c.Name) == "" {
+		return newError("client.name")
+	}
+	if c.LogoMediaID <= 0 {
+		return newError("client.logo")
+	}
+	if c.Website != "" && !isValidURL(c.Website) {
+		return newError("client.website")
+	}
+	if c.SortOrder < 0 {
+		return newError("client.order")
+	}
+	return nil
+}
+
+func ValidateSubscriber(s *model.Subscriber) error {
+	if strings.TrimSpace(s.Email) == "" {
+		return newError("email.required")
+	}
+	if !isValidEmail(s.Email) {
+		return newError("email.format")
 	}
 	return nil
 }
 
 func ValidateContact(c *model.Contact) error {
 	if strings.TrimSpace(c.Name) == "" {
-		return errors.New("contact name is required")
+		return newError("contact.name")
 	}
 	if strings.TrimSpace(c.Email) == "" {
-		return errors.New("contact email is required")
+		return newError("contact.email")
 	}
 	if !isValidEmail(c.Email) {
-		return errors.New("invalid email format")
+		return newError("email.format")
+	}
+	if len(c.Subject) > 200 {
+		return newError("contact.subject")
+	}
+	// Name and subject end up in mail headers.
+	if strings.ContainsAny(c.Name+c.Subject, "\r\n") {
+		return newError("contact.line_break")
 	}
 	if strings.TrimSpace(c.Message) == "" {
-		return errors.New("contact message is required")
+		return newError("contact.message")
 	}
 	return nil
 }
 
+func ValidateProfile(p *model.Profile) error {
+	if strings.TrimSpace(p.Name) == "" {
+		return newError("profile.name")
+	}
+	if p.Email != "" && !isValidEmail(p.Email) {
+		return newError("email.format")
+	}
+	if !slices.Contains(model.AvailabilityStatuses, p.Availability) {
+		return newError("profile.availability", strings.Join(model.AvailabilityStatuses, ", "))
+	}
+	for _, l := range p.Links {
+		if !validSlug.MatchString(l.Network) {
+			return newError("profile.network")
+		}
+		if !isValidURL(l.URL) {
+			return newError("profile.link", l.Network)
+		}
+	}
+	return validateLocales(p.Translations)
+}
+
+func ValidateContactStatus(status string) error {
+	if !slices.Contains(model.ContactStatuses, status) {
+		return newError("contact.status", strings.Join(model.ContactStatuses, ", "))
+	}
+	return nil
+}
+
+func isValidURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 func isValidEmail(email string) bool {
 	re := regexp.MustCompile(`^[a-zA-Z0-9._%%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	return re.MatchString(email)
@@ -1,8 +1,11 @@
 package validation
 
 import (
+	"errors"
 	"porto/model"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidatePortfolio(t *testing.T) {
@@ -11,9 +14,11 @@ func TestValidatePortfolio(t *testing.T) {
 		portfolio model.Portfolio
 		wantErr   bool
 	}{
-		{"valid", model.Portfolio{Name: "A", Description: "B"}, false},
-		{"empty name", model.Portfolio{Name: "", Description: "B"}, true},
-		{"empty desc", model.Portfolio{Name: "A", Description: ""}, true},
+		{"valid", model.Portfolio{Name: "A", Description: "B", Slug: "a-1"}, false},
+		{"empty name", model.Portfolio{Name: "", Description: "B", Slug: "a"}, true},
+		{"empty desc", model.Portfolio{Name: "A", Description: "", Slug: "a"}, true},
+		{"empty slug", model.Portfolio{Name: "A", Description: "B"}, true},
+		{"bad slug", model.Portfolio{Name: "A", Description: "B", Slug: "A_b-"}, true},
 	}
 	for _, c := range cases {
 		err := ValidatePortfolio(&c.portfolio)
@@ -23,6 +28,126 @@ func TestValidatePortfolio(t *testing.T) {
 	}
 }
 
+func TestValidateExperience(t *testing.T) {
+	start := model.NewDate(2020, time.January, 1)
+	end := model.NewDate(2021, time.June, 30)
+	cases := []struct {
+		name       string
+		experience model.Experience
+		wantErr    bool
+	}{
+		{"valid", model.Experience{Title: "A", Company: "B", StartDate: start, EndDate: end}, false},
+		{"valid current", model.Experience{Title: "A", Company: "B", StartDate: start, IsCurrent: true}, false},
+		{"empty title", model.Experience{Title: "", Company: "B", StartDate: start, EndDate: end}, true},
+		{"missing start", model.Experience{Title: "A", Company: "B", EndDate: end}, true},
+		{"missing end", model.Experience{Title: "A", Company: "B", StartDate: start}, true},
+		{"current with end", model.Experience{Title: "A", Company: "B", StartDate: start, EndDate: end, IsCurrent: true}, true},
+		{"end before start", model.Experience{Title: "A", Company: "B", StartDate: end, EndDate: start}, true},
+	}
+	for _, c := range cases {
+		err := ValidateExperience(&c.experience)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateSkill(t *testing.T) {
+	cases := []struct {
+		name    string
+		skill   model.Skill
+		wantErr bool
+	}{
+		{"valid", model.Skill{Name: "Go", Proficiency: model.ProficiencyExpert, Years: 3}, false},
+		{"empty name", model.Skill{Name: " ", Proficiency: model.ProficiencyExpert}, true},
+		{"unknown proficiency", model.Skill{Name: "Go", Proficiency: "guru"}, true},
+		{"negative years", model.Skill{Name: "Go", Proficiency: model.ProficiencyBeginner, Years: -1}, true},
+	}
+	for _, c := range cases {
+		err := ValidateSkill(&c.skill)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateService(t *testing.T) {
+	cases := []struct {
+		name    string
+		service model.Service
+		wantErr bool
+	}{
+		{"valid", model.Service{Title: "Web design", Description: "B", SortOrder: 1}, false},
+		{"empty title", model.Service{Title: "", Description: "B"}, true},
+		{"empty desc", model.Service{Title: "Web design", Description: ""}, true},
+		{"negative order", model.Service{Title: "Web design", Description: "B", SortOrder: -1}, true},
+	}
+	for _, c := range cases {
+		err := ValidateService(&c.service)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateTestimonial(t *testing.T) {
+	cases := []struct {
+		name        string
+		testimonial model.Testimonial
+		wantErr     bool
+	}{
+		{"valid", model.Testimonial{Author: "A", Quote: "Great", Rating: 5}, false},
+		{"empty author", model.Testimonial{Author: "", Quote: "Great", Rating: 5}, true},
+		{"empty quote", model.Testimonial{Author: "A", Quote: " ", Rating: 5}, true},
+		{"rating too low", model.Testimonial{Author: "A", Quote: "Great", Rating: 0}, true},
+		{"rating too high", model.Testimonial{Author: "A", Quote: "Great", Rating: 6}, true},
+	}
+	for _, c := range cases {
+		err := ValidateTestimonial(&c.testimonial)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateClient(t *testing.T) {
+	cases := []struct {
+		name    string
+		client  model.Client
+		wantErr bool
+	}{
+		{"valid", model.Client{Name: "Acme", LogoMediaID: 1, Website: "https://acme.test"}, false},
+		{"no website", model.Client{Name: "Acme", LogoMediaID: 1}, false},
+		{"empty name", model.Client{Name: "", LogoMediaID: 1}, true},
+		{"missing logo", model.Client{Name: "Acme"}, true},
+		{"bad website", model.Client{Name: "Acme", LogoMediaID: 1, Website: "javascript:alert(1)"}, true},
+	}
+	for _, c := range cases {
+		err := ValidateClient(&c.client)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateSubscriber(t *testing.T) {
+	cases := []struct {
+		name       string
+		subscriber model.Subscriber
+		wantErr    bool
+	}{
+		{"valid", model.Subscriber{Email: "a@mail.com"}, false},
+		{"empty email", model.Subscriber{Email: " "}, true},
+		{"invalid email", model.Subscriber{Email: "a"}, true},
+	}
+	for _, c := range cases {
+		err := ValidateSubscriber(&c.subscriber)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
 func TestValidateContact(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -33,6 +158,9 @@ func TestValidateContact(t *testing.T) {
 		{"empty name", model.Contact{Name: "", Email: "a@mail.com", Message: "hi"}, true},
 		{"invalid email", model.Contact{Name: "A", Email: "a", Message: "hi"}, true},
 		{"empty message", model.Contact{Name: "A", Email: "a@mail.com", Message: ""}, true},
+		{"long subject", model.Contact{Name: "A", Email: "a@mail.com", Subject: strings.Repeat("x", 201), Message: "hi"}, true},
+		{"line break in name", model.Contact{Name: "x\r\nBcc: b@mail.com", Email: "a@mail.com", Message: "hi"}, true},
+		{"line break in subject", model.Contact{Name: "A", Email: "a@mail.com", Subject: "Hi\nthere", Message: "hi"}, true},
 	}
 	for _, c := range cases {
 		err := ValidateContact(&c.contact)
@@ -41,3 +169,64 @@ func TestValidateContact(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateContactStatus(t *testing.T) {
+	for _, status := range model.ContactStatuses {
+		if err := ValidateContactStatus(status); err != nil {
+			t.Errorf("expected %q to be valid, got %v", status, err)
+		}
+	}
+	if err := ValidateContactStatus("deleted"); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
+
+func TestValidateProfile(t *testing.T) {
+	github := model.SocialLinks{{Network: "github", URL: "https://github.com/a"}}
+	cases := []struct {
+		name    string
+		profile model.Profile
+		wantErr bool
+	}{
+		{"valid", model.Profile{Name: "A", Email: "a@b.test", Availability: model.AvailabilityBusy, Links: github}, false},
+		{"empty name", model.Profile{Name: " ", Availability: model.AvailabilityAvailable}, true},
+		{"bad email", model.Profile{Name: "A", Email: "a@", Availability: model.AvailabilityAvailable}, true},
+		{"unknown availability", model.Profile{Name: "A", Availability: "away"}, true},
+		{"bad network", model.Profile{Name: "A", Availability: model.AvailabilityAvailable, Links: model.SocialLinks{{Network: "Git Hub", URL: "https://github.com/a"}}}, true},
+		{"bad link", model.Profile{Name: "A", Availability: model.AvailabilityAvailable, Links: model.SocialLinks{{Network: "github", URL: "github"}}}, true},
+		{"unsupported locale", model.Profile{Name: "A", Availability: model.AvailabilityAvailable, Translations: model.Translations[model.ProfileText]{"xx": {Bio: "b"}}}, true},
+	}
+	for _, c := range cases {
+		err := ValidateProfile(&c.profile)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestValidateTranslations(t *testing.T) {
+	p := model.Portfolio{Name: "A", Description: "B", Slug: "a",
+		Translations: model.Translations[model.PortfolioText]{"id": {Name: "A"}}}
+	if err := ValidatePortfolio(&p); err != nil {
+		t.Errorf("expected a supported locale to pass, got %v", err)
+	}
+	p.Translations["fr"] = model.PortfolioText{Name: "A"}
+	if err := ValidatePortfolio(&p); err == nil {
+		t.Error("expected an unsupported locale to fail")
+	}
+}
+
+func TestErrorLocalize(t *testing.T) {
+	err := ValidateContact(&model.Contact{Email: "a@mail.com", Message: "hi"})
+	var v *Error
+	if !errors.As(err, &v) {
+		t.Fatalf("expected a *Error, got %T", err)
+	}
+	if err.Error() != "contact name is required" || v.Localize("id") != "nama wajib diisi" {
+		t.Errorf("unexpected messages %q, %q", err.Error(), v.Localize("id"))
+	}
+	err = ValidateSkill(&model.Skill{Name: "Go", Proficiency: "guru"})
+	if msg := err.(*Error).Localize("id"); !strings.HasPrefix(msg, "tingkat kemahiran harus salah satu dari ") {
+		t.Errorf("expected the arguments in the Indonesian message, got %q", msg)
+	}
+}